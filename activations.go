@@ -0,0 +1,314 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Tanh applies hyperbolic tangent function on each cell of matrix and
+// returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Tanh() (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.UnaryOperation(math.Tanh, "Tanh")
+	return
+}
+
+// TanhDerivative computes derivative for hyperbolic tangent function on each
+// cell of matrix and returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) TanhDerivative() (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		tanh := math.Tanh(value)
+		return 1.0 - tanh*tanh
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "TanhDerivative")
+	return
+}
+
+// ReLU applies rectified linear unit function on each cell of matrix and
+// returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ReLU() (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.LeakyReLU(0)
+	return
+}
+
+// ReLUDerivative computes derivative for rectified linear unit function on
+// each cell of matrix and returns resulting Matrix. Derivative at 0 is
+// considered to be 0.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ReLUDerivative() (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.LeakyReLUDerivative(0)
+	return
+}
+
+// LeakyReLU applies leaky rectified linear unit function on each cell of
+// matrix and returns resulting Matrix. Negative values are multiplied by
+// `alpha`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) LeakyReLU(alpha float64) (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		if value > 0 {
+			return value
+		}
+
+		return alpha * value
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "LeakyReLU")
+	return
+}
+
+// LeakyReLUDerivative computes derivative for leaky rectified linear unit
+// function on each cell of matrix and returns resulting Matrix. Derivative
+// at 0 is considered to be `alpha`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) LeakyReLUDerivative(alpha float64) (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		if value > 0 {
+			return 1.0
+		}
+
+		return alpha
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "LeakyReLUDerivative")
+	return
+}
+
+// ELU applies exponential linear unit function on each cell of matrix and
+// returns resulting Matrix. Negative values tend toward `-alpha`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ELU(alpha float64) (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		if value > 0 {
+			return value
+		}
+
+		return alpha * math.Expm1(value)
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "ELU")
+	return
+}
+
+// ELUDerivative computes derivative for exponential linear unit function on
+// each cell of matrix and returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ELUDerivative(alpha float64) (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		if value > 0 {
+			return 1.0
+		}
+
+		return alpha * math.Exp(value)
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "ELUDerivative")
+	return
+}
+
+// GELU applies gaussian error linear unit function on each cell of matrix
+// and returns resulting Matrix. The exact form, using the error function, is
+// used rather than its tanh approximation.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) GELU() (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		return value * normalCDF(value)
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "GELU")
+	return
+}
+
+// GELUDerivative computes derivative for gaussian error linear unit function
+// on each cell of matrix and returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) GELUDerivative() (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		return normalCDF(value) + value*math.Exp(-value*value/2)/math.Sqrt(2*math.Pi)
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "GELUDerivative")
+	return
+}
+
+// Softplus applies softplus function, `log(1 + exp(x))`, on each cell of
+// matrix and returns resulting Matrix. It's computed in a way that doesn't
+// overflow for big values.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Softplus() (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.UnaryOperation(softplus, "Softplus")
+	return
+}
+
+// SoftplusDerivative computes derivative for softplus function on each cell
+// of matrix and returns resulting Matrix. This is the sigmoid function.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) SoftplusDerivative() (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.UnaryOperation(sigmoid, "SoftplusDerivative")
+	return
+}
+
+// Swish applies swish function, `x * sigmoid(x)`, on each cell of matrix and
+// returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Swish() (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		return value * sigmoid(value)
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "Swish")
+	return
+}
+
+// SwishDerivative computes derivative for swish function on each cell of
+// matrix and returns resulting Matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) SwishDerivative() (resultMatrix Matrix, err error) {
+	operation := func(value float64) float64 {
+		sigmoidValue := sigmoid(value)
+		return sigmoidValue + value*sigmoidValue*(1.0-sigmoidValue)
+	}
+
+	resultMatrix, err = matrix.UnaryOperation(operation, "SwishDerivative")
+	return
+}
+
+// Softmax applies softmax function on each row of matrix and returns
+// resulting Matrix, so that each row contains positive values summing up
+// to 1.
+//
+// Row maximum is substracted before exponentiation, so that big values
+// don't overflow.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Softmax() (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.LogSoftmax()
+	if err != nil {
+		return
+	}
+
+	err = resultMatrix.UnaryOperationInPlace(math.Exp, "Softmax")
+
+	return
+}
+
+// LogSoftmax applies the logarithm of softmax function on each row of matrix
+// and returns resulting Matrix.
+//
+// It's computed directly rather than by taking the logarithm of `Softmax()`,
+// so that very small probabilities don't turn into -Inf.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) LogSoftmax() (resultMatrix Matrix, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf("Can't apply LogSoftmax on matrix %v: matrix is not valid", matrix))
+		return
+	}
+
+	resultMatrix = ZeroMatrixFrom(matrix)
+	cols := matrix.Cols()
+	for i := 0; i < matrix.Rows(); i++ {
+		start := matrix.IndexFor(i, 0)
+		row := matrix[start : start+cols]
+
+		max := math.Inf(-1)
+		for _, value := range row {
+			max = math.Max(max, value)
+		}
+
+		sum := 0.0
+		for _, value := range row {
+			sum += math.Exp(value - max)
+		}
+
+		logSum := max + math.Log(sum)
+		for j, value := range row {
+			resultMatrix[start+j] = value - logSum
+		}
+	}
+
+	return
+}
+
+// SoftmaxBackward computes the gradient of a loss with regard to the input
+// of `Softmax()`, from the gradient of that loss with regard to its output.
+//
+// Since each output of softmax depends on all the inputs of its row, softmax
+// has no cell by cell derivative: this computes the product of
+// outputGradient with the jacobian of each row instead.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) SoftmaxBackward(outputGradient Matrix) (resultMatrix Matrix, err error) {
+	if !matrix.SameDimensions(outputGradient) {
+		err = generateError(fmt.Sprintf("Can't compute SoftmaxBackward: matrix %v and output gradient %v are not valid or not of the same dimension", matrix, outputGradient))
+		return
+	}
+
+	softmax, err := matrix.Softmax()
+	if err != nil {
+		return
+	}
+
+	// For each row: gradient = softmax * (outputGradient - sum(outputGradient * softmax))
+	weighted, _ := softmax.MultiplyCells(outputGradient)
+	sums, _ := weighted.SumAxis(Axis1)
+	resultMatrix, _ = outputGradient.SubstractBroadcast(sums)
+	err = resultMatrix.MultiplyCellsInPlace(softmax)
+
+	return
+}
+
+// LogSoftmaxBackward computes the gradient of a loss with regard to the
+// input of `LogSoftmax()`, from the gradient of that loss with regard to
+// its output.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) LogSoftmaxBackward(outputGradient Matrix) (resultMatrix Matrix, err error) {
+	if !matrix.SameDimensions(outputGradient) {
+		err = generateError(fmt.Sprintf("Can't compute LogSoftmaxBackward: matrix %v and output gradient %v are not valid or not of the same dimension", matrix, outputGradient))
+		return
+	}
+
+	softmax, err := matrix.Softmax()
+	if err != nil {
+		return
+	}
+
+	// For each row: gradient = outputGradient - softmax * sum(outputGradient)
+	sums, _ := outputGradient.SumAxis(Axis1)
+	scaled, _ := softmax.MultiplyBroadcast(sums)
+	resultMatrix, err = outputGradient.Substract(scaled)
+
+	return
+}
+
+// normalCDF is the cumulative distribution function of the standard normal
+// distribution.
+func normalCDF(value float64) float64 {
+	return 0.5 * (1.0 + math.Erf(value/math.Sqrt2))
+}
+
+// softplus computes `log(1 + exp(value))` without overflowing for big
+// values.
+func softplus(value float64) float64 {
+	return math.Max(value, 0) + math.Log1p(math.Exp(-math.Abs(value)))
+}
@@ -0,0 +1,211 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestActivations(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{-3, -0.5, 0.25},
+			Row{0.7, 2, 40},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("computing values", func(t *testing.T) {
+		activations := []struct {
+			name       string
+			activation func() (Matrix, error)
+			function   func(float64) float64
+		}{
+			{"Tanh", matrix.Tanh, math.Tanh},
+			{"ReLU", matrix.ReLU, func(x float64) float64 { return math.Max(x, 0) }},
+			{"LeakyReLU", func() (Matrix, error) { return matrix.LeakyReLU(0.1) }, func(x float64) float64 { return math.Max(x, 0.1*x) }},
+			{"ELU", func() (Matrix, error) { return matrix.ELU(2) }, func(x float64) float64 {
+				if x > 0 {
+					return x
+				}
+				return 2 * (math.Exp(x) - 1)
+			}},
+			{"GELU", matrix.GELU, func(x float64) float64 { return x / 2 * (1 + math.Erf(x/math.Sqrt2)) }},
+			{"Softplus", matrix.Softplus, func(x float64) float64 { return math.Log(1 + math.Exp(x)) }},
+			{"Swish", matrix.Swish, func(x float64) float64 { return x / (1 + math.Exp(-x)) }},
+		}
+
+		for _, activation := range activations {
+			result, err := activation.activation()
+			if err != nil {
+				t.Fatalf("Got an error while applying %s while none was expected: %v", activation.name, err)
+			}
+
+			expected, _ := matrix.UnaryOperation(activation.function, activation.name)
+			if !almostEqual(result, expected, 1e-12) {
+				t.Errorf("%s: expected :%s\nGot:%s", activation.name, expected, result)
+			}
+		}
+	})
+
+	t.Run("computing derivatives", func(t *testing.T) {
+		activations := []struct {
+			name       string
+			activation func(Matrix) (Matrix, error)
+			derivative func(Matrix) (Matrix, error)
+		}{
+			{"Tanh", Matrix.Tanh, Matrix.TanhDerivative},
+			{"ReLU", Matrix.ReLU, Matrix.ReLUDerivative},
+			{"LeakyReLU", func(m Matrix) (Matrix, error) { return m.LeakyReLU(0.1) }, func(m Matrix) (Matrix, error) { return m.LeakyReLUDerivative(0.1) }},
+			{"ELU", func(m Matrix) (Matrix, error) { return m.ELU(2) }, func(m Matrix) (Matrix, error) { return m.ELUDerivative(2) }},
+			{"GELU", Matrix.GELU, Matrix.GELUDerivative},
+			{"Softplus", Matrix.Softplus, Matrix.SoftplusDerivative},
+			{"Swish", Matrix.Swish, Matrix.SwishDerivative},
+		}
+
+		step := 1e-6
+		above, _ := matrix.UnaryOperation(func(x float64) float64 { return x + step }, "step")
+		below, _ := matrix.UnaryOperation(func(x float64) float64 { return x - step }, "step")
+
+		for _, activation := range activations {
+			result, err := activation.derivative(matrix)
+			if err != nil {
+				t.Fatalf("Got an error while applying %s derivative while none was expected: %v", activation.name, err)
+			}
+
+			aboveValues, _ := activation.activation(above)
+			belowValues, _ := activation.activation(below)
+			expected, _ := aboveValues.Substract(belowValues)
+			expected, _ = expected.ScalarMultiply(1 / (2 * step))
+
+			if !almostEqual(result, expected, 1e-6) {
+				t.Errorf("%s derivative: expected :%s\nGot:%s", activation.name, expected, result)
+			}
+		}
+	})
+
+	t.Run("with big values", func(t *testing.T) {
+		big, _ := Build(Builder{Row{-1000, 1000}})
+		result, err := big.Softplus()
+		if err != nil {
+			t.Fatalf("Got an error while applying Softplus while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{0, 1000}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+		if _, err := invalid.GELU(); err == nil {
+			t.Errorf("Got no error from invalid matrix")
+		}
+
+		if _, err := invalid.Softmax(); err == nil {
+			t.Errorf("Got no error from invalid matrix")
+		}
+	})
+}
+
+func TestSoftmax(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{1, 2, 3},
+			Row{1000, 1000, 1000},
+			Row{-5, 0, 800},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("computing softmax", func(t *testing.T) {
+		result, err := matrix.Softmax()
+		if err != nil {
+			t.Fatalf("Got an error while applying Softmax while none was expected: %v", err)
+		}
+
+		sum := math.Exp(1) + math.Exp(2) + math.Exp(3)
+		expected, _ := Build(
+			Builder{
+				Row{math.Exp(1) / sum, math.Exp(2) / sum, math.Exp(3) / sum},
+				Row{1.0 / 3, 1.0 / 3, 1.0 / 3},
+				Row{0, 0, 1},
+			},
+		)
+		if !almostEqual(result, expected, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("computing log softmax", func(t *testing.T) {
+		result, err := matrix.LogSoftmax()
+		if err != nil {
+			t.Fatalf("Got an error while applying LogSoftmax while none was expected: %v", err)
+		}
+
+		if math.Abs(result.At(2, 0)+805) > 1e-9 {
+			t.Errorf("Expected -805 for very small probability, got %v", result.At(2, 0))
+		}
+
+		if math.Abs(result.At(1, 1)+math.Log(3)) > 1e-12 {
+			t.Errorf("Expected %v, got %v", -math.Log(3), result.At(1, 1))
+		}
+	})
+
+	backwards := []struct {
+		name     string
+		forward  func(Matrix) (Matrix, error)
+		backward func(Matrix, Matrix) (Matrix, error)
+	}{
+		{"Softmax", Matrix.Softmax, Matrix.SoftmaxBackward},
+		{"LogSoftmax", Matrix.LogSoftmax, Matrix.LogSoftmaxBackward},
+	}
+
+	for _, backward := range backwards {
+		t.Run("computing "+backward.name+" gradient", func(t *testing.T) {
+			input, _ := Build(Builder{Row{0.5, -1, 2}, Row{0, 0.3, -0.2}})
+			outputGradient, _ := Build(Builder{Row{1, -2, 0.5}, Row{0.1, 3, -1}})
+
+			result, err := backward.backward(input, outputGradient)
+			if err != nil {
+				t.Fatalf("Got an error while computing gradient while none was expected: %v", err)
+			}
+
+			// Loss is sum(outputGradient * output), so that its gradient with
+			// regard to output is outputGradient.
+			loss := func(input Matrix) float64 {
+				output, _ := backward.forward(input)
+				weighted, _ := output.MultiplyCells(outputGradient)
+				sum, _ := weighted.Sum()
+				return sum
+			}
+
+			step := 1e-6
+			expected := ZeroMatrixFrom(input)
+			for i := 2; i < len(input); i++ {
+				original := input[i]
+				input[i] = original + step
+				above := loss(input)
+				input[i] = original - step
+				below := loss(input)
+				input[i] = original
+				expected[i] = (above - below) / (2 * step)
+			}
+
+			if !almostEqual(result, expected, 1e-6) {
+				t.Errorf("Expected :%s\nGot:%s", expected, result)
+			}
+		})
+	}
+
+	t.Run("with gradient of wrong dimensions", func(t *testing.T) {
+		_, err := matrix.SoftmaxBackward(GenerateMatrix(2, 3))
+		if err == nil {
+			t.Fatalf("Got no error from gradient of wrong dimensions")
+		}
+	})
+}
@@ -0,0 +1,489 @@
+package matrix
+
+import (
+	"fmt"
+)
+
+// Tape records operations performed on its variables, so that gradients can
+// be computed automatically by going through them backward (reverse mode
+// automatic differentiation).
+//
+// Operations on variables return an error whenever their `Matrix`
+// counterpart would. A tape is not safe for concurrent use.
+type Tape struct {
+	variables []*Variable
+}
+
+// Variable is a matrix recorded on a tape, either provided as an input with
+// `Tape.Variable()` or produced by an operation on other variables.
+type Variable struct {
+	tape     *Tape
+	value    Matrix
+	gradient Matrix
+
+	// backward adds to the gradients of the variables this one was computed
+	// from their part of gradient, which is the gradient of this variable.
+	backward func(gradient Matrix)
+}
+
+// NewTape creates an empty tape.
+func NewTape() *Tape {
+	return &Tape{}
+}
+
+// Variable records matrix on tape, so that the gradient with regard to it
+// can be computed.
+//
+// Error is returned if matrix is not valid.
+func (tape *Tape) Variable(matrix Matrix) (variable *Variable, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf("Can't record matrix %v: matrix is not valid", matrix))
+		return
+	}
+
+	variable = tape.record(matrix, nil)
+
+	return
+}
+
+// Value returns the matrix held by variable.
+func (variable *Variable) Value() Matrix {
+	return variable.value
+}
+
+// Gradient returns the gradient computed by the last call to `Backward()`
+// or `BackwardWith()`, which has the dimensions of variable value. It's a
+// zero matrix if variable doesn't contribute to the differentiated variable.
+func (variable *Variable) Gradient() Matrix {
+	if variable.gradient == nil {
+		return ZeroMatrixFrom(variable.value)
+	}
+
+	return variable.gradient
+}
+
+// Backward computes the gradient of variable with regard to all variables
+// recorded on tape before it, which can then be read with `Gradient()`.
+//
+// Error is returned if variable is not a 1x1 matrix, like the ones produced
+// by `Sum()` and `Mean()`. Use `BackwardWith()` otherwise.
+func (variable *Variable) Backward() (err error) {
+	if variable.value.Rows() != 1 || variable.value.Cols() != 1 {
+		err = generateError(fmt.Sprintf("Can't compute gradients: variable %v is not a 1x1 matrix, use BackwardWith()", variable.value))
+		return
+	}
+
+	err = variable.BackwardWith(Matrix{1, 1, 1})
+
+	return
+}
+
+// BackwardWith works like `Backward()`, but starts from the provided
+// gradient of variable, like the one returned with a loss by
+// `MeanSquaredError()`.
+//
+// Error is returned if gradient doesn't have the dimensions of variable.
+func (variable *Variable) BackwardWith(gradient Matrix) (err error) {
+	if !variable.value.SameDimensions(gradient) {
+		err = generateError(fmt.Sprintf("Can't compute gradients: gradient %v is not valid or not of the dimensions of %v", gradient, variable.value))
+		return
+	}
+
+	last := 0
+	for i, recorded := range variable.tape.variables {
+		recorded.gradient = nil
+		if recorded == variable {
+			last = i
+		}
+	}
+
+	variable.accumulate(gradient)
+	for i := last; i >= 0; i-- {
+		recorded := variable.tape.variables[i]
+		if recorded.backward != nil && recorded.gradient != nil {
+			recorded.backward(recorded.gradient)
+		}
+	}
+
+	return
+}
+
+// DotProduct records the standard multiplication of variable with other.
+//
+// Error is returned if variables don't come from the same tape or product
+// is undefined.
+func (variable *Variable) DotProduct(other *Variable) (result *Variable, err error) {
+	if err = variable.checkTape(other, "DotProduct"); err != nil {
+		return
+	}
+
+	value, err := variable.value.DotProduct(other.value)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		otherTransposed, _ := other.value.Transpose()
+		variableGradient, _ := gradient.DotProduct(otherTransposed)
+		variable.accumulate(variableGradient)
+
+		variableTransposed, _ := variable.value.Transpose()
+		otherGradient, _ := variableTransposed.DotProduct(gradient)
+		other.accumulate(otherGradient)
+	})
+
+	return
+}
+
+// Add records the addition of variable and other.
+//
+// Error is returned if variables don't come from the same tape or aren't of
+// same dimensions.
+func (variable *Variable) Add(other *Variable) (result *Variable, err error) {
+	if err = variable.checkTape(other, "Add"); err != nil {
+		return
+	}
+
+	value, err := variable.value.Add(other.value)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variable.accumulate(gradient)
+		other.accumulate(gradient)
+	})
+
+	return
+}
+
+// Substract records the substraction of other from variable.
+//
+// Error is returned if variables don't come from the same tape or aren't of
+// same dimensions.
+func (variable *Variable) Substract(other *Variable) (result *Variable, err error) {
+	if err = variable.checkTape(other, "Substract"); err != nil {
+		return
+	}
+
+	value, err := variable.value.Substract(other.value)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variable.accumulate(gradient)
+		otherGradient, _ := gradient.ScalarMultiply(-1)
+		other.accumulate(otherGradient)
+	})
+
+	return
+}
+
+// MultiplyCells records the cell by cell multiplication of variable and
+// other.
+//
+// Error is returned if variables don't come from the same tape or aren't of
+// same dimensions.
+func (variable *Variable) MultiplyCells(other *Variable) (result *Variable, err error) {
+	if err = variable.checkTape(other, "MultiplyCells"); err != nil {
+		return
+	}
+
+	value, err := variable.value.MultiplyCells(other.value)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := gradient.MultiplyCells(other.value)
+		variable.accumulate(variableGradient)
+
+		otherGradient, _ := gradient.MultiplyCells(variable.value)
+		other.accumulate(otherGradient)
+	})
+
+	return
+}
+
+// AddBroadcast records the addition of variable and other, broadcast as
+// described in `Matrix.BroadcastOperation()`. This is typically used to add
+// a 1xn bias row to each row of a batch.
+//
+// Error is returned if variables don't come from the same tape or can't be
+// broadcast together.
+func (variable *Variable) AddBroadcast(other *Variable) (result *Variable, err error) {
+	if err = variable.checkTape(other, "AddBroadcast"); err != nil {
+		return
+	}
+
+	value, err := variable.value.AddBroadcast(other.value)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variable.accumulate(unbroadcast(gradient, variable.value))
+		other.accumulate(unbroadcast(gradient, other.value))
+	})
+
+	return
+}
+
+// ScalarMultiply records the multiplication of each cell of variable with
+// scalar.
+func (variable *Variable) ScalarMultiply(scalar float64) (result *Variable, err error) {
+	value, err := variable.value.ScalarMultiply(scalar)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := gradient.ScalarMultiply(scalar)
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// Transpose records the transposition of variable.
+func (variable *Variable) Transpose() (result *Variable, err error) {
+	value, err := variable.value.Transpose()
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := gradient.Transpose()
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// Sigmoid records the application of sigmoid function on each cell of
+// variable.
+func (variable *Variable) Sigmoid() (result *Variable, err error) {
+	value, err := variable.value.Sigmoid()
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := gradient.BinaryOperation(value, func(gradient, output float64) float64 {
+			return gradient * output * (1 - output)
+		}, "SigmoidBackward")
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// Tanh records the application of hyperbolic tangent function on each cell
+// of variable.
+func (variable *Variable) Tanh() (result *Variable, err error) {
+	value, err := variable.value.Tanh()
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := gradient.BinaryOperation(value, func(gradient, output float64) float64 {
+			return gradient * (1 - output*output)
+		}, "TanhBackward")
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// ReLU records the application of rectified linear unit function on each
+// cell of variable.
+func (variable *Variable) ReLU() (result *Variable, err error) {
+	result, err = variable.recordActivation(Matrix.ReLU, Matrix.ReLUDerivative)
+	return
+}
+
+// LeakyReLU records the application of leaky rectified linear unit function
+// on each cell of variable.
+func (variable *Variable) LeakyReLU(alpha float64) (result *Variable, err error) {
+	result, err = variable.recordActivation(func(matrix Matrix) (Matrix, error) {
+		return matrix.LeakyReLU(alpha)
+	}, func(matrix Matrix) (Matrix, error) {
+		return matrix.LeakyReLUDerivative(alpha)
+	})
+	return
+}
+
+// ELU records the application of exponential linear unit function on each
+// cell of variable.
+func (variable *Variable) ELU(alpha float64) (result *Variable, err error) {
+	result, err = variable.recordActivation(func(matrix Matrix) (Matrix, error) {
+		return matrix.ELU(alpha)
+	}, func(matrix Matrix) (Matrix, error) {
+		return matrix.ELUDerivative(alpha)
+	})
+	return
+}
+
+// GELU records the application of gaussian error linear unit function on
+// each cell of variable.
+func (variable *Variable) GELU() (result *Variable, err error) {
+	result, err = variable.recordActivation(Matrix.GELU, Matrix.GELUDerivative)
+	return
+}
+
+// Softplus records the application of softplus function on each cell of
+// variable.
+func (variable *Variable) Softplus() (result *Variable, err error) {
+	result, err = variable.recordActivation(Matrix.Softplus, Matrix.SoftplusDerivative)
+	return
+}
+
+// Swish records the application of swish function on each cell of variable.
+func (variable *Variable) Swish() (result *Variable, err error) {
+	result, err = variable.recordActivation(Matrix.Swish, Matrix.SwishDerivative)
+	return
+}
+
+// Softmax records the application of softmax function on each row of
+// variable.
+func (variable *Variable) Softmax() (result *Variable, err error) {
+	value, err := variable.value.Softmax()
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := variable.value.SoftmaxBackward(gradient)
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// LogSoftmax records the application of log-softmax function on each row of
+// variable.
+func (variable *Variable) LogSoftmax() (result *Variable, err error) {
+	value, err := variable.value.LogSoftmax()
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variableGradient, _ := variable.value.LogSoftmaxBackward(gradient)
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// SumAxis records the sum of cells of variable along `axis`, as described in
+// `Matrix.Reduce()`.
+//
+// Error is returned if axis is unknown.
+func (variable *Variable) SumAxis(axis Axis) (result *Variable, err error) {
+	value, err := variable.value.SumAxis(axis)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		variable.accumulate(broadcastTo(gradient, variable.value))
+	})
+
+	return
+}
+
+// MeanAxis records the mean of cells of variable along `axis`. Averaging a
+// loss over the rows of a batch is done with `Axis0`.
+//
+// Error is returned if axis is unknown.
+func (variable *Variable) MeanAxis(axis Axis) (result *Variable, err error) {
+	sum, err := variable.SumAxis(axis)
+	if err != nil {
+		return
+	}
+
+	result, err = sum.ScalarMultiply(1 / float64(reducedCount(variable.value, axis)))
+
+	return
+}
+
+// Sum records the sum of all cells of variable, as a 1x1 matrix.
+func (variable *Variable) Sum() (result *Variable, err error) {
+	result, err = variable.SumAxis(AxisAll)
+	return
+}
+
+// Mean records the mean of all cells of variable, as a 1x1 matrix.
+func (variable *Variable) Mean() (result *Variable, err error) {
+	result, err = variable.MeanAxis(AxisAll)
+	return
+}
+
+// recordActivation records an activation applied cell by cell, which
+// gradient is computed from its derivative at variable cells.
+func (variable *Variable) recordActivation(activation, derivative func(Matrix) (Matrix, error)) (result *Variable, err error) {
+	value, err := activation(variable.value)
+	if err != nil {
+		return
+	}
+
+	result = variable.tape.record(value, func(gradient Matrix) {
+		derivatives, _ := derivative(variable.value)
+		variableGradient, _ := gradient.MultiplyCells(derivatives)
+		variable.accumulate(variableGradient)
+	})
+
+	return
+}
+
+// record adds a new variable to tape.
+func (tape *Tape) record(value Matrix, backward func(gradient Matrix)) *Variable {
+	variable := &Variable{tape: tape, value: value, backward: backward}
+	tape.variables = append(tape.variables, variable)
+
+	return variable
+}
+
+// accumulate adds gradient to the gradient of variable, since a variable
+// used several times receives gradient from each of its uses.
+func (variable *Variable) accumulate(gradient Matrix) {
+	if variable.gradient == nil {
+		variable.gradient = ZeroMatrixFrom(variable.value)
+	}
+
+	variable.gradient.AddInPlace(gradient)
+}
+
+// checkTape makes sure both variables can be used in the same operation.
+func (variable *Variable) checkTape(other *Variable, operationName string) (err error) {
+	if variable.tape != other.tape {
+		err = generateError(fmt.Sprintf(`Can't apply operation "%s": variables are not recorded on the same tape`, operationName))
+	}
+
+	return
+}
+
+// unbroadcast sums gradient along the rows and columns along which a matrix
+// of the dimensions of origin was broadcast, so that it has the dimensions
+// of origin again.
+func unbroadcast(gradient, origin Matrix) Matrix {
+	if origin.Rows() == 1 && gradient.Rows() != 1 {
+		gradient, _ = gradient.SumAxis(Axis0)
+	}
+
+	if origin.Cols() == 1 && gradient.Cols() != 1 {
+		gradient, _ = gradient.SumAxis(Axis1)
+	}
+
+	return gradient
+}
+
+// broadcastTo repeats a gradient reduced along some axes, so that it has the
+// dimensions of origin again. This is the reverse of `unbroadcast()`.
+func broadcastTo(gradient, origin Matrix) Matrix {
+	resultMatrix, _ := ZeroMatrixFrom(origin).AddBroadcast(gradient)
+	return resultMatrix
+}
@@ -0,0 +1,327 @@
+package matrix
+
+import (
+	"testing"
+)
+
+// checkGradients compares gradients computed by `Backward()` with the ones
+// computed with finite differences, for a function of inputs producing a 1x1
+// variable.
+func checkGradients(t *testing.T, inputs []Matrix, function func(variables []*Variable) (*Variable, error)) {
+	t.Helper()
+
+	evaluate := func() (*Variable, []*Variable) {
+		tape := NewTape()
+		variables := make([]*Variable, len(inputs))
+		for i, input := range inputs {
+			variable, err := tape.Variable(input)
+			if err != nil {
+				t.Fatalf("Got an error while recording input while none was expected: %v", err)
+			}
+			variables[i] = variable
+		}
+
+		output, err := function(variables)
+		if err != nil {
+			t.Fatalf("Got an error while computing function while none was expected: %v", err)
+		}
+
+		return output, variables
+	}
+
+	output, variables := evaluate()
+	if err := output.Backward(); err != nil {
+		t.Fatalf("Got an error while computing gradients while none was expected: %v", err)
+	}
+
+	step := 1e-6
+	for index, input := range inputs {
+		expected := ZeroMatrixFrom(input)
+		for i := 2; i < len(input); i++ {
+			original := input[i]
+			input[i] = original + step
+			above, _ := evaluate()
+			input[i] = original - step
+			below, _ := evaluate()
+			input[i] = original
+			expected[i] = (above.Value()[2] - below.Value()[2]) / (2 * step)
+		}
+
+		gradient := variables[index].Gradient()
+		if !almostEqual(gradient, expected, 1e-6) {
+			t.Errorf("Input %d: expected gradient :%s\nGot:%s", index, expected, gradient)
+		}
+	}
+}
+
+func TestAutodiffOperations(t *testing.T) {
+	a, _ := Build(Builder{Row{0.5, -1.2, 2}, Row{0.3, 0.8, -0.7}})
+	b, _ := Build(Builder{Row{1.5, 0.2, -0.4}, Row{-2, 0.6, 1.1}})
+	c, _ := Build(Builder{Row{0.1, -0.3}, Row{0.9, 0.4}, Row{-1, 0.25}})
+	bias, _ := Build(Builder{Row{0.3, -0.1, 0.05}})
+	column, _ := Build(Builder{Row{0.6}, Row{-1.3}})
+	weights, _ := Build(Builder{Row{1, -2, 0.5}, Row{0.3, 0.7, -1.5}})
+
+	// Weighting cells before summing them makes each gradient cell
+	// different.
+	weightedSum := func(variable *Variable, weights Matrix) (*Variable, error) {
+		weightsVariable, err := variable.tape.Variable(weights)
+		if err != nil {
+			return nil, err
+		}
+
+		weighted, err := variable.MultiplyCells(weightsVariable)
+		if err != nil {
+			return nil, err
+		}
+
+		return weighted.Sum()
+	}
+
+	operations := []struct {
+		name     string
+		inputs   []Matrix
+		function func(variables []*Variable) (*Variable, error)
+	}{
+		{"DotProduct", []Matrix{a, c}, func(variables []*Variable) (*Variable, error) {
+			product, _ := variables[0].DotProduct(variables[1])
+			square, _ := product.MultiplyCells(product)
+			return square.Sum()
+		}},
+		{"Add", []Matrix{a, b}, func(variables []*Variable) (*Variable, error) {
+			sum, _ := variables[0].Add(variables[1])
+			return weightedSum(sum, weights)
+		}},
+		{"Substract", []Matrix{a, b}, func(variables []*Variable) (*Variable, error) {
+			difference, _ := variables[0].Substract(variables[1])
+			return weightedSum(difference, weights)
+		}},
+		{"MultiplyCells", []Matrix{a, b}, func(variables []*Variable) (*Variable, error) {
+			product, _ := variables[0].MultiplyCells(variables[1])
+			return weightedSum(product, weights)
+		}},
+		{"AddBroadcast", []Matrix{a, bias}, func(variables []*Variable) (*Variable, error) {
+			sum, _ := variables[0].AddBroadcast(variables[1])
+			return weightedSum(sum, weights)
+		}},
+		{"ScalarMultiply", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			scaled, _ := variables[0].ScalarMultiply(-3)
+			return weightedSum(scaled, weights)
+		}},
+		{"Transpose", []Matrix{c}, func(variables []*Variable) (*Variable, error) {
+			transposed, _ := variables[0].Transpose()
+			return weightedSum(transposed, weights)
+		}},
+		{"Sigmoid", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].Sigmoid()
+			return weightedSum(activated, weights)
+		}},
+		{"Tanh", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].Tanh()
+			return weightedSum(activated, weights)
+		}},
+		{"ReLU", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].ReLU()
+			return weightedSum(activated, weights)
+		}},
+		{"LogSoftmax", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].LogSoftmax()
+			return weightedSum(activated, weights)
+		}},
+		{"LeakyReLU", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].LeakyReLU(0.1)
+			return weightedSum(activated, weights)
+		}},
+		{"ELU", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].ELU(1.5)
+			return weightedSum(activated, weights)
+		}},
+		{"GELU", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].GELU()
+			return weightedSum(activated, weights)
+		}},
+		{"Softplus", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].Softplus()
+			return weightedSum(activated, weights)
+		}},
+		{"Swish", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].Swish()
+			return weightedSum(activated, weights)
+		}},
+		{"Softmax", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			activated, _ := variables[0].Softmax()
+			return weightedSum(activated, weights)
+		}},
+		{"SumAxis along axis 0", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			square, _ := variables[0].MultiplyCells(variables[0])
+			sums, _ := square.SumAxis(Axis0)
+			return weightedSum(sums, bias)
+		}},
+		{"SumAxis along axis 1", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			square, _ := variables[0].MultiplyCells(variables[0])
+			sums, _ := square.SumAxis(Axis1)
+			return weightedSum(sums, column)
+		}},
+		{"MeanAxis along axis 0", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			square, _ := variables[0].MultiplyCells(variables[0])
+			means, _ := square.MeanAxis(Axis0)
+			return weightedSum(means, bias)
+		}},
+		{"MeanAxis along axis 1", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			square, _ := variables[0].MultiplyCells(variables[0])
+			means, _ := square.MeanAxis(Axis1)
+			return weightedSum(means, column)
+		}},
+		{"Mean", []Matrix{a}, func(variables []*Variable) (*Variable, error) {
+			square, _ := variables[0].MultiplyCells(variables[0])
+			return square.Mean()
+		}},
+	}
+
+	for _, operation := range operations {
+		t.Run(operation.name, func(t *testing.T) {
+			checkGradients(t, operation.inputs, operation.function)
+		})
+	}
+}
+
+func TestAutodiffNetwork(t *testing.T) {
+	inputs, _ := Build(Builder{Row{0.1, 0.9}, Row{-0.5, 0.3}, Row{0.7, -0.2}})
+	hiddenWeights, _ := Build(Builder{Row{0.4, -0.6, 0.2}, Row{0.1, 0.8, -0.3}})
+	hiddenBias, _ := Build(Builder{Row{0.05, -0.02, 0.1}})
+	outputWeights, _ := Build(Builder{Row{0.3}, Row{-0.7}, Row{0.5}})
+	targets, _ := Build(Builder{Row{1}, Row{0}, Row{1}})
+
+	network := func(variables []*Variable) (*Variable, error) {
+		hidden, err := variables[0].DotProduct(variables[1])
+		if err != nil {
+			return nil, err
+		}
+
+		hidden, err = hidden.AddBroadcast(variables[2])
+		if err != nil {
+			return nil, err
+		}
+
+		hidden, err = hidden.Tanh()
+		if err != nil {
+			return nil, err
+		}
+
+		outputs, err := hidden.DotProduct(variables[3])
+		if err != nil {
+			return nil, err
+		}
+
+		outputs, err = outputs.Sigmoid()
+		if err != nil {
+			return nil, err
+		}
+
+		targetsVariable, err := variables[0].tape.Variable(targets)
+		if err != nil {
+			return nil, err
+		}
+
+		errors, err := outputs.Substract(targetsVariable)
+		if err != nil {
+			return nil, err
+		}
+
+		squares, err := errors.MultiplyCells(errors)
+		if err != nil {
+			return nil, err
+		}
+
+		return squares.Mean()
+	}
+
+	t.Run("computing gradients of all inputs", func(t *testing.T) {
+		checkGradients(t, []Matrix{inputs, hiddenWeights, hiddenBias, outputWeights}, network)
+	})
+
+	t.Run("starting from a loss gradient", func(t *testing.T) {
+		tape := NewTape()
+		weights, _ := tape.Variable(outputWeights)
+		features, _ := tape.Variable(Matrix{3, 3, 0.2, -0.4, 0.9, 0.5, 0.1, -0.3, 0.6, 0.7, -0.8})
+		outputs, _ := features.DotProduct(weights)
+
+		_, lossGradient, err := outputs.Value().MeanSquaredError(targets)
+		if err != nil {
+			t.Fatalf("Got an error while computing loss while none was expected: %v", err)
+		}
+
+		if err := outputs.BackwardWith(lossGradient); err != nil {
+			t.Fatalf("Got an error while computing gradients while none was expected: %v", err)
+		}
+
+		expected, _ := features.Value().Transpose()
+		expected, _ = expected.DotProduct(lossGradient)
+		if !almostEqual(weights.Gradient(), expected, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, weights.Gradient())
+		}
+	})
+
+	t.Run("calling backward several times", func(t *testing.T) {
+		tape := NewTape()
+		x, _ := tape.Variable(Matrix{1, 1, 3})
+		square, _ := x.MultiplyCells(x)
+		cube, _ := square.MultiplyCells(x)
+
+		square.Backward()
+		cube.Backward()
+
+		if x.Gradient()[2] != 27 {
+			t.Errorf("Expected gradients to be reset between calls, got %v", x.Gradient()[2])
+		}
+
+		if unused, _ := tape.Variable(Matrix{1, 2, 5, 6}); !unused.Gradient().EqualTo(Matrix{1, 2, 0, 0}) {
+			t.Errorf("Expected zero gradient for unused variable, got %v", unused.Gradient())
+		}
+	})
+}
+
+func TestAutodiffErrors(t *testing.T) {
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := NewTape().Variable(Matrix([]float64{10, 10, 1}))
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+
+	t.Run("with variables from different tapes", func(t *testing.T) {
+		a, _ := NewTape().Variable(GenerateMatrix(2, 2))
+		b, _ := NewTape().Variable(GenerateMatrix(2, 2))
+		_, err := a.Add(b)
+		if err == nil {
+			t.Fatalf("Got no error from variables of different tapes")
+		}
+	})
+
+	t.Run("with incompatible dimensions", func(t *testing.T) {
+		tape := NewTape()
+		a, _ := tape.Variable(GenerateMatrix(2, 3))
+		_, err := a.DotProduct(a)
+		if err == nil {
+			t.Fatalf("Got no error from undefined product")
+		}
+	})
+
+	t.Run("with an unknown axis", func(t *testing.T) {
+		a, _ := NewTape().Variable(GenerateMatrix(2, 3))
+		if _, err := a.MeanAxis(Axis(2)); err == nil {
+			t.Errorf("Got no error from unknown axis")
+		}
+	})
+
+	t.Run("differentiating a non scalar variable", func(t *testing.T) {
+		a, _ := NewTape().Variable(GenerateMatrix(2, 3))
+		if err := a.Backward(); err == nil {
+			t.Errorf("Got no error while differentiating a 2x3 variable")
+		}
+
+		if err := a.BackwardWith(GenerateMatrix(3, 2)); err == nil {
+			t.Errorf("Got no error from gradient of wrong dimensions")
+		}
+	})
+}
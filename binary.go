@@ -0,0 +1,215 @@
+package matrix
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Binary format is made of a 24 bytes header followed by cell values, row by
+// row, as IEEE 754 float64:
+//
+//	bytes 0-3:   magic number, "MTRX"
+//	byte 4:      format version
+//	byte 5:      endianness of following numbers, 0 for little endian, 1 for big endian
+//	bytes 6-7:   reserved, always 0
+//	bytes 8-15:  rows count, as uint64
+//	bytes 16-23: cols count, as uint64
+const (
+	binaryMagic         = "MTRX"
+	binaryVersion       = 1
+	binaryHeaderSize    = 24
+	binaryLittleEndian  = 0
+	binaryBigEndian     = 1
+	binaryChunkSize     = 512
+	binaryValueByteSize = 8
+)
+
+// MarshalBinary encodes matrix in a versioned binary format, using little
+// endian. It implements `encoding.BinaryMarshaler`, which means matrices can
+// be directly used with `encoding/gob`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) MarshalBinary() (data []byte, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't encode matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	data = make([]byte, binaryHeaderSize+(len(matrix)-2)*binaryValueByteSize)
+	putBinaryHeader(data, matrix.Rows(), matrix.Cols())
+	for i := 2; i < len(matrix); i++ {
+		binary.LittleEndian.PutUint64(data[binaryHeaderSize+(i-2)*binaryValueByteSize:], math.Float64bits(matrix[i]))
+	}
+
+	return
+}
+
+// UnmarshalBinary decodes a matrix encoded with `MarshalBinary()` or
+// `WriteTo()`, and stores it in matrix. It implements
+// `encoding.BinaryUnmarshaler`.
+//
+// Error is returned if data is not a valid encoded matrix, including if its
+// payload length does not match its dimensions.
+func (matrix *Matrix) UnmarshalBinary(data []byte) (err error) {
+	if len(data) < binaryHeaderSize {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: %d bytes is too short for header`, len(data)))
+		return
+	}
+
+	rows, cols, order, err := readBinaryHeader(data[:binaryHeaderSize])
+	if err != nil {
+		return
+	}
+
+	payload := data[binaryHeaderSize:]
+	if len(payload)%binaryValueByteSize != 0 || uint64(len(payload)/binaryValueByteSize) != rows*cols {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: payload length (%d bytes) does not match %dx%d dimensions`, len(payload), rows, cols))
+		return
+	}
+
+	resultMatrix := GenerateMatrix(int(rows), int(cols))
+	for i := 2; i < len(resultMatrix); i++ {
+		resultMatrix[i] = math.Float64frombits(order.Uint64(payload[(i-2)*binaryValueByteSize:]))
+	}
+
+	*matrix = resultMatrix
+	return
+}
+
+// WriteTo streams matrix to writer in the same format than
+// `MarshalBinary()`, without having to hold the whole encoded matrix in
+// memory. It implements `io.WriterTo`.
+//
+// Error is returned if matrix is not valid or if writing failed.
+func (matrix Matrix) WriteTo(writer io.Writer) (n int64, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't encode matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	header := make([]byte, binaryHeaderSize)
+	putBinaryHeader(header, matrix.Rows(), matrix.Cols())
+	written, err := writer.Write(header)
+	n += int64(written)
+	if err != nil {
+		return
+	}
+
+	buffer := make([]byte, binaryChunkSize*binaryValueByteSize)
+	for start := 2; start < len(matrix); start += binaryChunkSize {
+		end := start + binaryChunkSize
+		if end > len(matrix) {
+			end = len(matrix)
+		}
+
+		for i := start; i < end; i++ {
+			binary.LittleEndian.PutUint64(buffer[(i-start)*binaryValueByteSize:], math.Float64bits(matrix[i]))
+		}
+
+		written, err = writer.Write(buffer[:(end-start)*binaryValueByteSize])
+		n += int64(written)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// ReadFrom reads a single matrix encoded with `WriteTo()` or
+// `MarshalBinary()` from reader, and stores it in matrix. It implements
+// `io.ReaderFrom`.
+//
+// Reading stops right after the matrix payload, so several matrices can be
+// read one after the other from the same stream.
+//
+// Error is returned if header is not valid or if stream ends before the
+// payload announced by the header was read.
+func (matrix *Matrix) ReadFrom(reader io.Reader) (n int64, err error) {
+	header := make([]byte, binaryHeaderSize)
+	read, err := io.ReadFull(reader, header)
+	n += int64(read)
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: can't read header: %v`, err))
+		return
+	}
+
+	rows, cols, order, err := readBinaryHeader(header)
+	if err != nil {
+		return
+	}
+
+	// matrix is grown chunk by chunk, so that a corrupted header can't make
+	// us allocate more memory than the stream actually provides
+	resultMatrix := Matrix{float64(rows), float64(cols)}
+	remaining := rows * cols
+	buffer := make([]byte, binaryChunkSize*binaryValueByteSize)
+	for remaining > 0 {
+		count := uint64(binaryChunkSize)
+		if remaining < count {
+			count = remaining
+		}
+
+		read, err = io.ReadFull(reader, buffer[:count*binaryValueByteSize])
+		n += int64(read)
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't decode matrix: payload is shorter than %dx%d dimensions: %v`, rows, cols, err))
+			return
+		}
+
+		for i := uint64(0); i < count; i++ {
+			resultMatrix = append(resultMatrix, math.Float64frombits(order.Uint64(buffer[i*binaryValueByteSize:])))
+		}
+		remaining -= count
+	}
+
+	*matrix = resultMatrix
+	return
+}
+
+// putBinaryHeader writes the binary format header for a little endian
+// payload of given dimensions in data.
+func putBinaryHeader(data []byte, rows, cols int) {
+	copy(data, binaryMagic)
+	data[4] = binaryVersion
+	data[5] = binaryLittleEndian
+	data[6] = 0
+	data[7] = 0
+	binary.LittleEndian.PutUint64(data[8:], uint64(rows))
+	binary.LittleEndian.PutUint64(data[16:], uint64(cols))
+}
+
+// readBinaryHeader validates a binary format header, and returns the
+// dimensions and byte order it describes.
+func readBinaryHeader(header []byte) (rows, cols uint64, order binary.ByteOrder, err error) {
+	if string(header[:4]) != binaryMagic {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: invalid magic number %q`, header[:4]))
+		return
+	}
+
+	if header[4] == 0 || header[4] > binaryVersion {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: unsupported format version %d`, header[4]))
+		return
+	}
+
+	switch header[5] {
+	case binaryLittleEndian:
+		order = binary.LittleEndian
+	case binaryBigEndian:
+		order = binary.BigEndian
+	default:
+		err = generateError(fmt.Sprintf(`Can't decode matrix: invalid endianness %d`, header[5]))
+		return
+	}
+
+	rows = order.Uint64(header[8:])
+	cols = order.Uint64(header[16:])
+	if rows == 0 || cols == 0 || rows > math.MaxInt32 || cols > math.MaxInt32 {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: invalid %dx%d dimensions`, rows, cols))
+		return
+	}
+
+	return
+}
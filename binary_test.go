@@ -0,0 +1,213 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, -2.5, math.Inf(1)},
+				Row{4, 5, 6e100},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		data, err := matrix.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if len(data) != 24+6*8 {
+			t.Errorf("Expected 72 bytes, got %d", len(data))
+		}
+
+		if string(data[:4]) != "MTRX" || data[4] != 1 || data[5] != 0 {
+			t.Errorf("Unexpected header: %v", data[:8])
+		}
+
+		var actual Matrix
+		err = actual.UnmarshalBinary(data)
+		if err != nil {
+			t.Fatalf("Got an error while decoding while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(matrix) {
+			t.Errorf("Expected :%s\nGot:%s", matrix, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.MarshalBinary()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+
+	t.Run("with gob", func(t *testing.T) {
+		matrix := RandomMatrix(3, 4)
+
+		var buffer bytes.Buffer
+		err := gob.NewEncoder(&buffer).Encode(matrix)
+		if err != nil {
+			t.Fatalf("Got an error while encoding while none was expected: %v", err)
+		}
+
+		var actual Matrix
+		err = gob.NewDecoder(&buffer).Decode(&actual)
+		if err != nil {
+			t.Fatalf("Got an error while decoding while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(matrix) {
+			t.Errorf("Expected :%s\nGot:%s", matrix, actual)
+		}
+	})
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	valid, err := Build(
+		Builder{
+			Row{1, 2},
+			Row{3, 4},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	data, err := valid.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Got an error while encoding matrix while none was expected: %v", err)
+	}
+
+	t.Run("with big endian data", func(t *testing.T) {
+		bigEndian := make([]byte, len(data))
+		copy(bigEndian, data[:8])
+		bigEndian[5] = 1
+		binary.BigEndian.PutUint64(bigEndian[8:], 2)
+		binary.BigEndian.PutUint64(bigEndian[16:], 2)
+		for i := 0; i < 4; i++ {
+			binary.BigEndian.PutUint64(bigEndian[24+i*8:], math.Float64bits(float64(i+1)))
+		}
+
+		var actual Matrix
+		err := actual.UnmarshalBinary(bigEndian)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(valid) {
+			t.Errorf("Expected :%s\nGot:%s", valid, actual)
+		}
+	})
+
+	corruptions := map[string]func([]byte) []byte{
+		"with a too short header": func(data []byte) []byte { return data[:10] },
+		"with an invalid magic number": func(data []byte) []byte {
+			data[0] = 'X'
+			return data
+		},
+		"with an unsupported version": func(data []byte) []byte {
+			data[4] = 2
+			return data
+		},
+		"with an invalid endianness": func(data []byte) []byte {
+			data[5] = 3
+			return data
+		},
+		"with zero rows": func(data []byte) []byte {
+			binary.LittleEndian.PutUint64(data[8:], 0)
+			return data[:24]
+		},
+		"with a truncated payload": func(data []byte) []byte { return data[:len(data)-1] },
+		"with an extra payload":    func(data []byte) []byte { return append(data, make([]byte, 8)...) },
+		"with mismatching dimensions": func(data []byte) []byte {
+			binary.LittleEndian.PutUint64(data[8:], 3)
+			return data
+		},
+	}
+
+	for name, corrupt := range corruptions {
+		corrupt := corrupt
+		t.Run(name, func(t *testing.T) {
+			corrupted := make([]byte, len(data))
+			copy(corrupted, data)
+
+			var actual Matrix
+			err := actual.UnmarshalBinary(corrupt(corrupted))
+			if err == nil {
+				t.Fatalf("Got no error with corrupted data.")
+			}
+		})
+	}
+}
+
+func TestWriteToReadFrom(t *testing.T) {
+	t.Run("with several matrices", func(t *testing.T) {
+		matrices := []Matrix{RandomMatrix(3, 4), RandomMatrix(30, 40), RandomMatrix(1, 1)}
+
+		var buffer bytes.Buffer
+		total := int64(0)
+		for _, matrix := range matrices {
+			n, err := matrix.WriteTo(&buffer)
+			if err != nil {
+				t.Fatalf("Got an error while writing while none was expected: %v", err)
+			}
+
+			expectedSize := int64(24 + 8*(len(matrix)-2))
+			if n != expectedSize {
+				t.Errorf("Expected %d bytes written, got %d", expectedSize, n)
+			}
+			total += n
+		}
+
+		if int64(buffer.Len()) != total {
+			t.Errorf("Expected %d bytes in buffer, got %d", total, buffer.Len())
+		}
+
+		for i, matrix := range matrices {
+			var actual Matrix
+			_, err := actual.ReadFrom(&buffer)
+			if err != nil {
+				t.Fatalf("Got an error while reading matrix %d while none was expected: %v", i, err)
+			}
+
+			if !actual.EqualTo(matrix) {
+				t.Errorf("Expected :%s\nGot:%s", matrix, actual)
+			}
+		}
+	})
+
+	t.Run("with a truncated stream", func(t *testing.T) {
+		data, err := RandomMatrix(3, 4).MarshalBinary()
+		if err != nil {
+			t.Fatalf("Got an error while encoding matrix while none was expected: %v", err)
+		}
+
+		var actual Matrix
+		_, err = actual.ReadFrom(bytes.NewReader(data[:len(data)-4]))
+		if err == nil {
+			t.Fatalf("Got no error with a truncated stream.")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		var buffer bytes.Buffer
+		_, err := matrix.WriteTo(&buffer)
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package matrix
+
+import (
+	"fmt"
+)
+
+// BroadcastOperation works like `BinaryOperation()`, but also accepts
+// matrices of different dimensions, following NumPy broadcasting rules: for
+// each of rows and columns, both matrices must have the same count, or one
+// of them must have a count of 1, in which case its single row or column is
+// reused for all rows or columns of the other matrix.
+//
+// This allows to add a 1xn bias row to each row of a mxn matrix, to
+// multiply each column of a mxn matrix by a mx1 column, or to apply a 1x1
+// matrix as a scalar. Dimensions of the resulting matrix are the biggest
+// ones of both matrices, so that a mx1 column and a 1xn row produce a mxn
+// matrix.
+//
+// Returns error if any matrix is invalid, or if their dimensions can't be
+// broadcast together.
+func (matrix Matrix) BroadcastOperation(otherMatrix Matrix, operation func(float64, float64) float64, operationName string) (resultMatrix Matrix, err error) {
+	if !matrix.Valid() || !otherMatrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't apply operation "%s" on matrices %v and %v: matrix is not valid`, operationName, matrix, otherMatrix))
+		return
+	}
+
+	rows, rowsOk := broadcastDimension(matrix.Rows(), otherMatrix.Rows())
+	cols, colsOk := broadcastDimension(matrix.Cols(), otherMatrix.Cols())
+	if !rowsOk || !colsOk {
+		err = generateError(fmt.Sprintf(`Can't apply operation "%s": %dx%d and %dx%d matrices can't be broadcast together`, operationName, matrix.Rows(), matrix.Cols(), otherMatrix.Rows(), otherMatrix.Cols()))
+		return
+	}
+
+	resultMatrix = GenerateMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		row, otherRow := i, i
+		if matrix.Rows() == 1 {
+			row = 0
+		}
+		if otherMatrix.Rows() == 1 {
+			otherRow = 0
+		}
+
+		for j := 0; j < cols; j++ {
+			col, otherCol := j, j
+			if matrix.Cols() == 1 {
+				col = 0
+			}
+			if otherMatrix.Cols() == 1 {
+				otherCol = 0
+			}
+
+			resultMatrix.SetAt(i, j, operation(matrix.At(row, col), otherMatrix.At(otherRow, otherCol)))
+		}
+	}
+
+	return
+}
+
+// AddBroadcast adds up matrix and otherMatrix, broadcasting them as
+// described in `BroadcastOperation()`.
+//
+// Returns error if any matrix is invalid, or if their dimensions can't be
+// broadcast together.
+func (matrix Matrix) AddBroadcast(otherMatrix Matrix) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.BroadcastOperation(otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 + value2
+	}, "AddBroadcast")
+	return
+}
+
+// SubstractBroadcast removes otherMatrix from matrix, broadcasting them as
+// described in `BroadcastOperation()`.
+//
+// Returns error if any matrix is invalid, or if their dimensions can't be
+// broadcast together.
+func (matrix Matrix) SubstractBroadcast(otherMatrix Matrix) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.BroadcastOperation(otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 - value2
+	}, "SubstractBroadcast")
+	return
+}
+
+// MultiplyBroadcast multiplies cells of matrix with cells of otherMatrix,
+// broadcasting them as described in `BroadcastOperation()`.
+//
+// Returns error if any matrix is invalid, or if their dimensions can't be
+// broadcast together.
+func (matrix Matrix) MultiplyBroadcast(otherMatrix Matrix) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.BroadcastOperation(otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 * value2
+	}, "MultiplyBroadcast")
+	return
+}
+
+// DivideBroadcast divides cells of matrix by cells of otherMatrix,
+// broadcasting them as described in `BroadcastOperation()`.
+//
+// Returns error if any matrix is invalid, or if their dimensions can't be
+// broadcast together.
+func (matrix Matrix) DivideBroadcast(otherMatrix Matrix) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.BroadcastOperation(otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 / value2
+	}, "DivideBroadcast")
+	return
+}
+
+// broadcastDimension computes the count of rows or columns resulting from
+// broadcasting together a dimension of two matrices.
+func broadcastDimension(count, otherCount int) (result int, ok bool) {
+	switch {
+	case count == otherCount:
+		return count, true
+	case count == 1:
+		return otherCount, true
+	case otherCount == 1:
+		return count, true
+	}
+
+	return 0, false
+}
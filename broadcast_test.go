@@ -0,0 +1,107 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBroadcastOperation(t *testing.T) {
+	batch, err := Build(
+		Builder{
+			Row{1, 2, 3},
+			Row{4, 5, 6},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("with a row vector", func(t *testing.T) {
+		bias, _ := Build(Builder{Row{10, 20, 30}})
+		result, err := batch.AddBroadcast(bias)
+		if err != nil {
+			t.Fatalf("Got an error while broadcasting while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{11, 22, 33}, Row{14, 25, 36}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with a column vector", func(t *testing.T) {
+		scale, _ := Build(Builder{Row{2}, Row{-1}})
+		result, err := batch.MultiplyBroadcast(scale)
+		if err != nil {
+			t.Fatalf("Got an error while broadcasting while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{2, 4, 6}, Row{-4, -5, -6}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with a scalar", func(t *testing.T) {
+		scalar, _ := Build(Builder{Row{2}})
+		result, err := batch.DivideBroadcast(scalar)
+		if err != nil {
+			t.Fatalf("Got an error while broadcasting while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{0.5, 1, 1.5}, Row{2, 2.5, 3}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+
+		result, err = scalar.SubstractBroadcast(batch)
+		if err != nil {
+			t.Fatalf("Got an error while broadcasting while none was expected: %v", err)
+		}
+
+		expected, _ = Build(Builder{Row{1, 0, -1}, Row{-2, -3, -4}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with a column and a row", func(t *testing.T) {
+		col, _ := Build(Builder{Row{1}, Row{2}})
+		row, _ := Build(Builder{Row{10, 20, 30}})
+		result, err := col.AddBroadcast(row)
+		if err != nil {
+			t.Fatalf("Got an error while broadcasting while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{11, 21, 31}, Row{12, 22, 32}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with same dimensions", func(t *testing.T) {
+		result, err := batch.BroadcastOperation(batch, math.Max, "Max")
+		if err != nil {
+			t.Fatalf("Got an error while broadcasting while none was expected: %v", err)
+		}
+
+		if !result.EqualTo(batch) {
+			t.Errorf("Expected :%s\nGot:%s", batch, result)
+		}
+	})
+
+	t.Run("with incompatible dimensions", func(t *testing.T) {
+		row, _ := Build(Builder{Row{1, 2}})
+		_, err := batch.AddBroadcast(row)
+		if err == nil {
+			t.Fatalf("Got no error from incompatible dimensions")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := batch.AddBroadcast(Matrix([]float64{10, 10, 1}))
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
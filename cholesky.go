@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Cholesky holds the Cholesky factorization of a symmetric positive-definite
+// matrix, so that:
+//
+//	matrix = L * LT
+//
+// L is lower triangular with a positive diagonal.
+type Cholesky struct {
+	l Matrix
+}
+
+// CholeskyDecompose computes the Cholesky factorization of a symmetric
+// positive-definite matrix.
+//
+// Error is returned if matrix is not valid, is not symmetric or is not
+// positive-definite.
+func (matrix Matrix) CholeskyDecompose() (cholesky Cholesky, err error) {
+	if !matrix.Valid() || !matrix.symmetric() {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is not valid or not symmetric`, matrix))
+		return
+	}
+
+	size := matrix.Rows()
+	l := GenerateMatrix(size, size)
+
+	for j := 0; j < size; j++ {
+		diagonal := matrix.At(j, j)
+		for k := 0; k < j; k++ {
+			diagonal -= l.At(j, k) * l.At(j, k)
+		}
+
+		if diagonal <= 0 || math.IsNaN(diagonal) {
+			err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is not positive-definite`, matrix))
+			return
+		}
+
+		diagonal = math.Sqrt(diagonal)
+		l.SetAt(j, j, diagonal)
+
+		for i := j + 1; i < size; i++ {
+			sum := matrix.At(i, j)
+			for k := 0; k < j; k++ {
+				sum -= l.At(i, k) * l.At(j, k)
+			}
+			l.SetAt(i, j, sum/diagonal)
+		}
+	}
+
+	cholesky.l = l
+	return
+}
+
+// L returns the lower triangular factor.
+func (cholesky Cholesky) L() Matrix {
+	resultMatrix := make(Matrix, len(cholesky.l))
+	copy(resultMatrix, cholesky.l)
+
+	return resultMatrix
+}
+
+// Solve finds `x` so that `matrix * x = b`, matrix being the factorized
+// matrix. `b` can have several columns, in which case each column is solved
+// independently.
+//
+// Error is returned if b is not valid or if its rows count does not match
+// the factorized matrix size.
+func (cholesky Cholesky) Solve(b Matrix) (resultMatrix Matrix, err error) {
+	size := cholesky.l.Rows()
+	if !b.Valid() || b.Rows() != size {
+		err = generateError(fmt.Sprintf(`Can't solve system: %v is not a valid right-hand side for a %dx%d matrix`, b, size, size))
+		return
+	}
+
+	cols := b.Cols()
+	resultMatrix = make(Matrix, len(b))
+	copy(resultMatrix, b)
+
+	// L * y = b
+	for k := 0; k < size; k++ {
+		for j := 0; j < cols; j++ {
+			resultMatrix[resultMatrix.IndexFor(k, j)] /= cholesky.l.At(k, k)
+		}
+
+		for i := k + 1; i < size; i++ {
+			factor := cholesky.l.At(i, k)
+			for j := 0; j < cols; j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] -= factor * resultMatrix.At(k, j)
+			}
+		}
+	}
+
+	// LT * x = y
+	for k := size - 1; k >= 0; k-- {
+		for j := 0; j < cols; j++ {
+			resultMatrix[resultMatrix.IndexFor(k, j)] /= cholesky.l.At(k, k)
+		}
+
+		for i := 0; i < k; i++ {
+			factor := cholesky.l.At(k, i)
+			for j := 0; j < cols; j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] -= factor * resultMatrix.At(k, j)
+			}
+		}
+	}
+
+	return
+}
+
+// Inverse returns the inverse of the factorized matrix.
+func (cholesky Cholesky) Inverse() Matrix {
+	resultMatrix, _ := cholesky.Solve(IdentityMatrix(cholesky.l.Rows()))
+
+	return resultMatrix
+}
+
+// LogDeterminant returns the natural logarithm of the determinant of the
+// factorized matrix. This is more robust than computing the determinant for
+// big matrices, which easily overflows.
+func (cholesky Cholesky) LogDeterminant() float64 {
+	logDeterminant := 0.0
+	for i := 0; i < cholesky.l.Rows(); i++ {
+		logDeterminant += 2 * math.Log(cholesky.l.At(i, i))
+	}
+
+	return logDeterminant
+}
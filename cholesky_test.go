@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCholeskyDecompose(t *testing.T) {
+	t.Run("with a positive-definite matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{4, 12, -16},
+				Row{12, 37, -43},
+				Row{-16, -43, 98},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{2, 0, 0},
+				Row{6, 1, 0},
+				Row{-8, 5, 3},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		cholesky, err := matrix.CholeskyDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, cholesky.L(), 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, cholesky.L())
+		}
+	})
+
+	t.Run("with a non positive-definite matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 1},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.CholeskyDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with a non positive-definite matrix.")
+		}
+	})
+
+	t.Run("with a non symmetric matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{4, 1},
+				Row{2, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.CholeskyDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with a non symmetric matrix.")
+		}
+	})
+
+	t.Run("with debug mode", func(t *testing.T) {
+		SetDebug(true)
+		defer SetDebug(false)
+
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Did not panic with a non positive-definite matrix in debug mode.")
+			}
+		}()
+
+		matrix, _ := Build(
+			Builder{
+				Row{-1, 0},
+				Row{0, -1},
+			},
+		)
+
+		matrix.CholeskyDecompose()
+	})
+}
+
+func TestCholeskyHelpers(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{4, 2, 0.6},
+			Row{2, 5, 1},
+			Row{0.6, 1, 3},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	cholesky, err := matrix.CholeskyDecompose()
+	if err != nil {
+		t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+	}
+
+	t.Run("Solve", func(t *testing.T) {
+		b, err := Build(
+			Builder{
+				Row{1, 0},
+				Row{2, 1},
+				Row{3, 0},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building b while none was expected: %v", err)
+		}
+
+		actual, err := cholesky.Solve(b)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		product, _ := matrix.DotProduct(actual)
+		if !almostEqual(b, product, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", b, product)
+		}
+
+		_, err = cholesky.Solve(GenerateMatrix(2, 1))
+		if err == nil {
+			t.Errorf("Got no error with mismatching right-hand side.")
+		}
+	})
+
+	t.Run("Inverse", func(t *testing.T) {
+		expected, err := matrix.Inverse()
+		if err != nil {
+			t.Fatalf("Got an error while computing inverse while none was expected: %v", err)
+		}
+
+		actual := cholesky.Inverse()
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("LogDeterminant", func(t *testing.T) {
+		determinant, err := matrix.Determinant()
+		if err != nil {
+			t.Fatalf("Got an error while computing determinant while none was expected: %v", err)
+		}
+
+		actual := cholesky.LogDeterminant()
+		if math.Abs(actual-math.Log(determinant)) > 1e-12 {
+			t.Errorf("Expected %f, got %f", math.Log(determinant), actual)
+		}
+	})
+}
@@ -0,0 +1,237 @@
+package matrix
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// MissingValuePolicy tells what to do when reading an empty cell (or a cell
+// matching one of `CSVReadOptions.MissingTokens`).
+type MissingValuePolicy int
+
+const (
+	// MissingError returns an error.
+	MissingError MissingValuePolicy = iota
+
+	// MissingFill replaces missing values with `CSVReadOptions.FillValue`
+	// (use `math.NaN()` to keep track of them).
+	MissingFill
+
+	// MissingSkipRow ignores rows containing a missing value.
+	MissingSkipRow
+)
+
+// CSVReadOptions configures `ReadCSV()`.
+type CSVReadOptions struct {
+	// Delimiter separates fields, ',' is used if not provided. Use '\t' for
+	// TSV files.
+	Delimiter rune
+
+	// Comment, if provided, makes lines starting with it ignored.
+	Comment rune
+
+	// SkipHeader ignores the first line.
+	SkipHeader bool
+
+	// Columns lists the indexes (0-indexed) of the columns to read, in the
+	// order they should appear in the matrix. All columns are read if empty.
+	Columns []int
+
+	// MissingValue decides what happens with missing values.
+	MissingValue MissingValuePolicy
+
+	// FillValue is used for missing values with `MissingFill` policy.
+	FillValue float64
+
+	// MissingTokens lists values, besides empty cells, to consider as
+	// missing (eg: "NA").
+	MissingTokens []string
+}
+
+// CSVWriteOptions configures `WriteCSV()`.
+type CSVWriteOptions struct {
+	// Delimiter separates fields, ',' is used if not provided. Use '\t' for
+	// TSV files.
+	Delimiter rune
+
+	// Header, if provided, is written as first line.
+	Header []string
+
+	// Format and Precision are passed to `strconv.FormatFloat()`. If Format
+	// is not provided, the shortest representation allowing to read the
+	// exact same value back is used.
+	Format    byte
+	Precision int
+}
+
+// ReadCSV streams CSV (or TSV) records from reader into a new matrix, one
+// row per record.
+//
+// Error is returned if reader contains no data row, if records do not all
+// have the same number of fields, if a requested column does not exist, if a
+// cell is not a number or if a missing value is met with `MissingError`
+// policy.
+func ReadCSV(reader io.Reader, options CSVReadOptions) (resultMatrix Matrix, err error) {
+	csvReader := csv.NewReader(reader)
+	if options.Delimiter != 0 {
+		csvReader.Comma = options.Delimiter
+	}
+	csvReader.Comment = options.Comment
+	csvReader.TrimLeadingSpace = true
+	csvReader.ReuseRecord = true
+	csvReader.FieldsPerRecord = -1
+
+	missingTokens := map[string]bool{"": true}
+	for _, token := range options.MissingTokens {
+		missingTokens[token] = true
+	}
+
+	resultMatrix = Matrix{0, 0}
+	rows, cols, fields := 0, 0, -1
+	values := []float64{}
+
+	for recordIndex := 0; ; recordIndex++ {
+		var record []string
+		record, err = csvReader.Read()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't read CSV: %v`, err))
+			resultMatrix = nil
+			return
+		}
+
+		if recordIndex == 0 && options.SkipHeader {
+			continue
+		}
+
+		line, _ := csvReader.FieldPos(0)
+
+		if fields == -1 {
+			fields = len(record)
+			cols = len(options.Columns)
+			if cols == 0 {
+				cols = fields
+			}
+		}
+
+		if len(record) != fields {
+			err = generateError(fmt.Sprintf(`Can't read CSV: line %d has %d fields instead of %d`, line, len(record), fields))
+			resultMatrix = nil
+			return
+		}
+
+		values = values[:0]
+		missing := false
+		for j := 0; j < cols; j++ {
+			index := j
+			if len(options.Columns) > 0 {
+				index = options.Columns[j]
+			}
+
+			if index < 0 || index >= len(record) {
+				err = generateError(fmt.Sprintf(`Can't read CSV: column %d does not exist in a %d fields record`, index, len(record)))
+				resultMatrix = nil
+				return
+			}
+
+			field := strings.TrimSpace(record[index])
+			if missingTokens[field] {
+				switch options.MissingValue {
+				case MissingFill:
+					values = append(values, options.FillValue)
+					continue
+				case MissingSkipRow:
+					missing = true
+				default:
+					err = generateError(fmt.Sprintf(`Can't read CSV: missing value at line %d, column %d`, line, index))
+					resultMatrix = nil
+					return
+				}
+				break
+			}
+
+			var value float64
+			value, err = strconv.ParseFloat(field, 64)
+			if err != nil {
+				err = generateError(fmt.Sprintf(`Can't read CSV: invalid number %q at line %d, column %d`, field, line, index))
+				resultMatrix = nil
+				return
+			}
+			values = append(values, value)
+		}
+
+		if missing {
+			continue
+		}
+
+		resultMatrix = append(resultMatrix, values...)
+		rows++
+	}
+
+	if rows == 0 || cols == 0 {
+		err = generateError(`Can't read CSV: no data found`)
+		resultMatrix = nil
+		return
+	}
+
+	resultMatrix[0] = float64(rows)
+	resultMatrix[1] = float64(cols)
+
+	return
+}
+
+// WriteCSV writes matrix to writer as CSV (or TSV), one record per row.
+//
+// Error is returned if matrix is not valid or if writing failed.
+func (matrix Matrix) WriteCSV(writer io.Writer, options CSVWriteOptions) (err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't write matrix %v as CSV: matrix is not valid`, matrix))
+		return
+	}
+
+	csvWriter := csv.NewWriter(writer)
+	if options.Delimiter != 0 {
+		csvWriter.Comma = options.Delimiter
+	}
+
+	format, precision := options.Format, options.Precision
+	if format == 0 {
+		format, precision = 'g', -1
+	}
+
+	if len(options.Header) > 0 {
+		err = csvWriter.Write(options.Header)
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't write CSV: %v`, err))
+			return
+		}
+	}
+
+	record := make([]string, matrix.Cols())
+	for i := 0; i < matrix.Rows(); i++ {
+		for j := range record {
+			record[j] = strconv.FormatFloat(matrix.At(i, j), format, precision, 64)
+		}
+
+		err = csvWriter.Write(record)
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't write CSV: %v`, err))
+			return
+		}
+	}
+
+	csvWriter.Flush()
+	err = csvWriter.Error()
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't write CSV: %v`, err))
+	}
+
+	return
+}
@@ -0,0 +1,182 @@
+package matrix
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	t.Run("with a valid CSV", func(t *testing.T) {
+		input := "a,b,c\n1,2,3\n4, 5.5 ,-6\n"
+
+		expected, err := Build(
+			Builder{
+				Row{1, 2, 3},
+				Row{4, 5.5, -6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := ReadCSV(strings.NewReader(input), CSVReadOptions{SkipHeader: true})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a TSV and column selection", func(t *testing.T) {
+		input := "# comment\n1\t2\t3\n4\t5\t6\n"
+
+		expected, err := Build(
+			Builder{
+				Row{3, 1},
+				Row{6, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := ReadCSV(strings.NewReader(input), CSVReadOptions{Delimiter: '\t', Comment: '#', Columns: []int{2, 0}})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with missing values", func(t *testing.T) {
+		input := "1,2\n,3\n4,NA\n5,6\n"
+
+		_, err := ReadCSV(strings.NewReader(input), CSVReadOptions{})
+		if err == nil {
+			t.Errorf("Got no error with missing values.")
+		}
+
+		actual, err := ReadCSV(strings.NewReader(input), CSVReadOptions{MissingValue: MissingFill, FillValue: math.NaN(), MissingTokens: []string{"NA"}})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if actual.Rows() != 4 || !math.IsNaN(actual.At(1, 0)) || !math.IsNaN(actual.At(2, 1)) || actual.At(3, 1) != 6 {
+			t.Errorf("Expected missing values to be filled with NaN, got %s", actual)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{5, 6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err = ReadCSV(strings.NewReader(input), CSVReadOptions{MissingValue: MissingSkipRow, MissingTokens: []string{"NA"}})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	invalids := map[string]struct {
+		input   string
+		options CSVReadOptions
+	}{
+		"with ragged records":     {"1,2\n3\n", CSVReadOptions{}},
+		"with a non number":       {"1,foo\n", CSVReadOptions{}},
+		"with no data":            {"a,b\n", CSVReadOptions{SkipHeader: true}},
+		"with an unknown column":  {"1,2\n", CSVReadOptions{Columns: []int{2}}},
+		"with an empty reader":    {"", CSVReadOptions{}},
+		"with a malformed record": {"1,\"2\n", CSVReadOptions{}},
+	}
+
+	for name, invalid := range invalids {
+		invalid := invalid
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadCSV(strings.NewReader(invalid.input), invalid.options)
+			if err == nil {
+				t.Fatalf("Got no error with invalid input %q", invalid.input)
+			}
+		})
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{1, 2.5},
+			Row{-3, 0.1},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("with default options", func(t *testing.T) {
+		var buffer bytes.Buffer
+		err := matrix.WriteCSV(&buffer, CSVWriteOptions{})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := "1,2.5\n-3,0.1\n"
+		if buffer.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, buffer.String())
+		}
+	})
+
+	t.Run("with custom options", func(t *testing.T) {
+		var buffer bytes.Buffer
+		err := matrix.WriteCSV(&buffer, CSVWriteOptions{Delimiter: '\t', Header: []string{"x", "y"}, Format: 'f', Precision: 2})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := "x\ty\n1.00\t2.50\n-3.00\t0.10\n"
+		if buffer.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, buffer.String())
+		}
+	})
+
+	t.Run("with a round trip", func(t *testing.T) {
+		expected := RandomMatrix(10, 4)
+
+		var buffer bytes.Buffer
+		err := expected.WriteCSV(&buffer, CSVWriteOptions{})
+		if err != nil {
+			t.Fatalf("Got an error while writing while none was expected: %v", err)
+		}
+
+		actual, err := ReadCSV(&buffer, CSVReadOptions{})
+		if err != nil {
+			t.Fatalf("Got an error while reading while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+
+		var buffer bytes.Buffer
+		err := invalid.WriteCSV(&buffer, CSVWriteOptions{})
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
@@ -0,0 +1,502 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// symmetryThreshold is the relative difference under which two cells are
+// considered equal when checking a matrix is symmetric.
+const symmetryThreshold = 1e-10
+
+// epsilon is the machine epsilon for float64.
+var epsilon = math.Nextafter(1, 2) - 1
+
+// EigenDecomposition holds eigenvalues and eigenvectors of a symmetric
+// matrix, so that:
+//
+//	matrix = Vectors * diag(Values) * VectorsT
+type EigenDecomposition struct {
+	values  []float64
+	vectors Matrix
+}
+
+// SymmetricEigen computes eigenvalues and eigenvectors of a symmetric matrix
+// using the cyclic Jacobi method. Eigenvalues are sorted in decreasing order.
+//
+// Error is returned if matrix is not valid, is not symmetric or if
+// computation did not converge within `MaxIterations` sweeps.
+func (matrix Matrix) SymmetricEigen() (eigen EigenDecomposition, err error) {
+	if !matrix.Valid() || !matrix.symmetric() {
+		err = generateError(fmt.Sprintf(`Can't compute eigenvalues of matrix %v: matrix is not valid or not symmetric`, matrix))
+		return
+	}
+
+	size := matrix.Rows()
+	work := make(Matrix, len(matrix))
+	copy(work, matrix)
+	vectors := IdentityMatrix(size)
+
+	// Rotations leave off-diagonal residues of the order of epsilon, so
+	// convergence is reached when a whole sweep has no cell worth rotating,
+	// rather than when off-diagonal cells reach a given total.
+	converged := false
+	for sweep := 0; sweep < MaxIterations; sweep++ {
+		rotated := false
+		for p := 0; p < size-1; p++ {
+			for q := p + 1; q < size; q++ {
+				if work.jacobiRotate(vectors, p, q) {
+					rotated = true
+				}
+			}
+		}
+
+		if !rotated {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		err = generateError(fmt.Sprintf(`Can't compute eigenvalues of matrix %v: no convergence after %d sweeps`, matrix, MaxIterations))
+		return
+	}
+
+	order := make([]int, size)
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return work.At(order[a], order[a]) > work.At(order[b], order[b])
+	})
+
+	eigen.values = make([]float64, size)
+	eigen.vectors = GenerateMatrix(size, size)
+	for j, index := range order {
+		eigen.values[j] = work.At(index, index)
+		for i := 0; i < size; i++ {
+			eigen.vectors.SetAt(i, j, vectors.At(i, index))
+		}
+	}
+
+	return
+}
+
+// Values returns the eigenvalues, in decreasing order.
+func (eigen EigenDecomposition) Values() []float64 {
+	values := make([]float64, len(eigen.values))
+	copy(values, eigen.values)
+
+	return values
+}
+
+// Vectors returns a matrix which columns are the normalized eigenvectors, in
+// the same order than `Values()`.
+func (eigen EigenDecomposition) Vectors() Matrix {
+	resultMatrix := make(Matrix, len(eigen.vectors))
+	copy(resultMatrix, eigen.vectors)
+
+	return resultMatrix
+}
+
+// Eigenvalues computes the eigenvalues of a square matrix, which may be
+// complex. The matrix is reduced to Hessenberg form, then the eigenvalues
+// are found using shifted QR iterations.
+//
+// Eigenvalues are sorted by decreasing real part, then by decreasing
+// imaginary part.
+//
+// Error is returned if matrix is not valid, is not square or if computation
+// did not converge within `MaxIterations` iterations for an eigenvalue.
+func (matrix Matrix) Eigenvalues() (values []complex128, err error) {
+	if !matrix.Valid() || matrix.Rows() != matrix.Cols() {
+		err = generateError(fmt.Sprintf(`Can't compute eigenvalues of matrix %v: matrix is not valid or not square`, matrix))
+		return
+	}
+
+	hessenberg := make(Matrix, len(matrix))
+	copy(hessenberg, matrix)
+	hessenberg.reduceToHessenberg()
+
+	values, converged := hessenberg.hessenbergEigenvalues()
+	if !converged {
+		values = nil
+		err = generateError(fmt.Sprintf(`Can't compute eigenvalues of matrix %v: no convergence after %d iterations`, matrix, MaxIterations))
+		return
+	}
+
+	sort.SliceStable(values, func(a, b int) bool {
+		if real(values[a]) != real(values[b]) {
+			return real(values[a]) > real(values[b])
+		}
+
+		return imag(values[a]) > imag(values[b])
+	})
+
+	return
+}
+
+// symmetric tells if a valid matrix is square and equal to its transpose.
+func (matrix Matrix) symmetric() bool {
+	size := matrix.Rows()
+	if size != matrix.Cols() {
+		return false
+	}
+
+	threshold := symmetryThreshold * maxAbs(matrix)
+	for i := 0; i < size; i++ {
+		for j := i + 1; j < size; j++ {
+			if math.Abs(matrix.At(i, j)-matrix.At(j, i)) > threshold {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// jacobiRotate applies, in place, the Jacobi rotation zeroing cell (p, q)
+// of the symmetric matrix, and accumulates it in vectors.
+//
+// Cell (p, q) is left untouched if it's negligible compared to the diagonal
+// cells (p, p) and (q, q), in which case false is returned.
+func (matrix Matrix) jacobiRotate(vectors Matrix, p, q int) (rotated bool) {
+	apq := matrix.At(p, q)
+	if math.Abs(apq) <= epsilon*math.Sqrt(math.Abs(matrix.At(p, p)*matrix.At(q, q))) {
+		return false
+	}
+
+	theta := (matrix.At(q, q) - matrix.At(p, p)) / (2 * apq)
+	tangent := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+	if theta < 0 {
+		tangent = -tangent
+	}
+	cosine := 1 / math.Sqrt(tangent*tangent+1)
+	sine := tangent * cosine
+
+	size := matrix.Rows()
+	for k := 0; k < size; k++ {
+		akp, akq := matrix.At(k, p), matrix.At(k, q)
+		matrix.SetAt(k, p, cosine*akp-sine*akq)
+		matrix.SetAt(k, q, sine*akp+cosine*akq)
+	}
+
+	for k := 0; k < size; k++ {
+		apk, aqk := matrix.At(p, k), matrix.At(q, k)
+		matrix.SetAt(p, k, cosine*apk-sine*aqk)
+		matrix.SetAt(q, k, sine*apk+cosine*aqk)
+	}
+
+	// Rotation zeroes (p, q) and (q, p) mathematically, but rounding leaves a
+	// residue that would be rotated again at each sweep.
+	matrix.SetAt(p, q, 0)
+	matrix.SetAt(q, p, 0)
+
+	for k := 0; k < size; k++ {
+		vkp, vkq := vectors.At(k, p), vectors.At(k, q)
+		vectors.SetAt(k, p, cosine*vkp-sine*vkq)
+		vectors.SetAt(k, q, sine*vkp+cosine*vkq)
+	}
+
+	return true
+}
+
+// reduceToHessenberg transforms, in place, a square matrix into a similar
+// upper Hessenberg matrix using Householder reflections.
+func (matrix Matrix) reduceToHessenberg() {
+	size := matrix.Rows()
+	high := size - 1
+	ort := make([]float64, size)
+
+	for m := 1; m < high; m++ {
+		scale := 0.0
+		for i := m; i <= high; i++ {
+			scale += math.Abs(matrix.At(i, m-1))
+		}
+
+		if scale == 0 {
+			continue
+		}
+
+		h := 0.0
+		for i := high; i >= m; i-- {
+			ort[i] = matrix.At(i, m-1) / scale
+			h += ort[i] * ort[i]
+		}
+
+		g := math.Sqrt(h)
+		if ort[m] > 0 {
+			g = -g
+		}
+		h -= ort[m] * g
+		ort[m] -= g
+
+		for j := m; j < size; j++ {
+			f := 0.0
+			for i := high; i >= m; i-- {
+				f += ort[i] * matrix.At(i, j)
+			}
+			f /= h
+
+			for i := m; i <= high; i++ {
+				matrix[matrix.IndexFor(i, j)] -= f * ort[i]
+			}
+		}
+
+		for i := 0; i <= high; i++ {
+			f := 0.0
+			for j := high; j >= m; j-- {
+				f += ort[j] * matrix.At(i, j)
+			}
+			f /= h
+
+			for j := m; j <= high; j++ {
+				matrix[matrix.IndexFor(i, j)] -= f * ort[j]
+			}
+		}
+
+		ort[m] *= scale
+		matrix.SetAt(m, m-1, scale*g)
+	}
+}
+
+// hessenbergEigenvalues finds the eigenvalues of an upper Hessenberg matrix
+// using Francis double shift QR iterations, modifying matrix in place. It
+// reports whether all eigenvalues were found within `MaxIterations`
+// iterations each.
+//
+// This is adapted from the `hqr2` procedure of the public domain JAMA
+// library, itself derived from EISPACK.
+func (matrix Matrix) hessenbergEigenvalues() (values []complex128, converged bool) {
+	size := matrix.Rows()
+	values = make([]complex128, size)
+	norm := 0.0
+	for i := 0; i < size; i++ {
+		start := i - 1
+		if start < 0 {
+			start = 0
+		}
+
+		for j := start; j < size; j++ {
+			norm += math.Abs(matrix.At(i, j))
+		}
+	}
+
+	// Deflation tests are relative to norm, so they can't succeed on a zero
+	// matrix, which eigenvalues are all zero anyway.
+	if norm == 0 {
+		return values, true
+	}
+
+	n := size - 1
+	exshift := 0.0
+	iteration := 0
+	var p, q, r, s, z, w, x, y float64
+
+	for n >= 0 {
+		l := n
+		for l > 0 {
+			s = math.Abs(matrix.At(l-1, l-1)) + math.Abs(matrix.At(l, l))
+			if s == 0 {
+				s = norm
+			}
+
+			if math.Abs(matrix.At(l, l-1)) < epsilon*s {
+				break
+			}
+			l--
+		}
+
+		if l == n {
+			matrix.SetAt(n, n, matrix.At(n, n)+exshift)
+			values[n] = complex(matrix.At(n, n), 0)
+			n--
+			iteration = 0
+			continue
+		}
+
+		if l == n-1 {
+			w = matrix.At(n, n-1) * matrix.At(n-1, n)
+			p = (matrix.At(n-1, n-1) - matrix.At(n, n)) / 2
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			matrix.SetAt(n, n, matrix.At(n, n)+exshift)
+			matrix.SetAt(n-1, n-1, matrix.At(n-1, n-1)+exshift)
+			x = matrix.At(n, n)
+
+			if q >= 0 {
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+
+				values[n-1] = complex(x+z, 0)
+				values[n] = values[n-1]
+				if z != 0 {
+					values[n] = complex(x-w/z, 0)
+				}
+			} else {
+				values[n-1] = complex(x+p, z)
+				values[n] = complex(x+p, -z)
+			}
+
+			n -= 2
+			iteration = 0
+			continue
+		}
+
+		x = matrix.At(n, n)
+		y = matrix.At(n-1, n-1)
+		w = matrix.At(n, n-1) * matrix.At(n-1, n)
+
+		if iteration == 10 {
+			exshift += x
+			for i := 0; i <= n; i++ {
+				matrix.SetAt(i, i, matrix.At(i, i)-x)
+			}
+
+			s = math.Abs(matrix.At(n, n-1)) + math.Abs(matrix.At(n-1, n-2))
+			x = 0.75 * s
+			y = x
+			w = -0.4375 * s * s
+		}
+
+		if iteration == 30 {
+			s = (y - x) / 2
+			s = s*s + w
+			if s > 0 {
+				s = math.Sqrt(s)
+				if y < x {
+					s = -s
+				}
+
+				s = x - w/((y-x)/2+s)
+				for i := 0; i <= n; i++ {
+					matrix.SetAt(i, i, matrix.At(i, i)-s)
+				}
+				exshift += s
+				x = 0.964
+				y = x
+				w = x
+			}
+		}
+
+		iteration++
+		if iteration > MaxIterations {
+			return
+		}
+
+		m := n - 2
+		for m >= l {
+			z = matrix.At(m, m)
+			r = x - z
+			s = y - z
+			p = (r*s-w)/matrix.At(m+1, m) + matrix.At(m, m+1)
+			q = matrix.At(m+1, m+1) - z - r - s
+			r = matrix.At(m+2, m+1)
+			s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+			p /= s
+			q /= s
+			r /= s
+
+			if m == l {
+				break
+			}
+
+			if math.Abs(matrix.At(m, m-1))*(math.Abs(q)+math.Abs(r)) < epsilon*(math.Abs(p)*(math.Abs(matrix.At(m-1, m-1))+math.Abs(z)+math.Abs(matrix.At(m+1, m+1)))) {
+				break
+			}
+			m--
+		}
+
+		for i := m + 2; i <= n; i++ {
+			matrix.SetAt(i, i-2, 0)
+			if i > m+2 {
+				matrix.SetAt(i, i-3, 0)
+			}
+		}
+
+		for k := m; k <= n-1; k++ {
+			notLast := k != n-1
+			if k != m {
+				p = matrix.At(k, k-1)
+				q = matrix.At(k+1, k-1)
+				r = 0
+				if notLast {
+					r = matrix.At(k+2, k-1)
+				}
+
+				x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				if x == 0 {
+					continue
+				}
+
+				p /= x
+				q /= x
+				r /= x
+			}
+
+			s = math.Sqrt(p*p + q*q + r*r)
+			if p < 0 {
+				s = -s
+			}
+
+			if s == 0 {
+				continue
+			}
+
+			if k != m {
+				matrix.SetAt(k, k-1, -s*x)
+			} else if l != m {
+				matrix.SetAt(k, k-1, -matrix.At(k, k-1))
+			}
+
+			p += s
+			x = p / s
+			y = q / s
+			z = r / s
+			q /= p
+			r /= p
+
+			for j := k; j < size; j++ {
+				p = matrix.At(k, j) + q*matrix.At(k+1, j)
+				if notLast {
+					p += r * matrix.At(k+2, j)
+					matrix.SetAt(k+2, j, matrix.At(k+2, j)-p*z)
+				}
+
+				matrix.SetAt(k, j, matrix.At(k, j)-p*x)
+				matrix.SetAt(k+1, j, matrix.At(k+1, j)-p*y)
+			}
+
+			last := n
+			if k+3 < last {
+				last = k + 3
+			}
+
+			for i := 0; i <= last; i++ {
+				p = x*matrix.At(i, k) + y*matrix.At(i, k+1)
+				if notLast {
+					p += z * matrix.At(i, k+2)
+					matrix.SetAt(i, k+2, matrix.At(i, k+2)-p*r)
+				}
+
+				matrix.SetAt(i, k, matrix.At(i, k)-p)
+				matrix.SetAt(i, k+1, matrix.At(i, k+1)-p*q)
+			}
+		}
+	}
+
+	for _, value := range values {
+		if cmplx.IsNaN(value) {
+			return
+		}
+	}
+
+	converged = true
+	return
+}
@@ -0,0 +1,290 @@
+package matrix
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestSymmetricEigen(t *testing.T) {
+	t.Run("with a small symmetric matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{2, 1},
+				Row{1, 2},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		eigen, err := matrix.SymmetricEigen()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := []float64{3, 1}
+		for i, value := range eigen.Values() {
+			if math.Abs(value-expected[i]) > 1e-12 {
+				t.Errorf("At position %d, expected %f, got %f.", i, expected[i], value)
+			}
+		}
+	})
+
+	t.Run("with a random symmetric matrix", func(t *testing.T) {
+		random := RandomMatrix(6, 6)
+		transposed, _ := random.Transpose()
+		matrix, err := random.Add(transposed)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		eigen, err := matrix.SymmetricEigen()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		values := eigen.Values()
+		vectors := eigen.Vectors()
+		for i := 1; i < len(values); i++ {
+			if values[i] > values[i-1] {
+				t.Errorf("Expected eigenvalues in decreasing order, got %v", values)
+			}
+		}
+
+		left, err := matrix.DotProduct(vectors)
+		if err != nil {
+			t.Fatalf("Got an error while computing A * V while none was expected: %v", err)
+		}
+
+		right := ZeroMatrixFrom(vectors)
+		for i := 0; i < right.Rows(); i++ {
+			for j := 0; j < right.Cols(); j++ {
+				right.SetAt(i, j, vectors.At(i, j)*values[j])
+			}
+		}
+
+		if !almostEqual(left, right, 1e-9) {
+			t.Errorf("Expected A * V to equal V * D.\nA * V:%s\nV * D:%s", left, right)
+		}
+	})
+
+	t.Run("with big random symmetric matrices", func(t *testing.T) {
+		// Jacobi converges in a few sweeps: a low cap makes sure it's not
+		// reached by looping until MaxIterations.
+		previous := MaxIterations
+		SetMaxIterations(30)
+		defer SetMaxIterations(previous)
+
+		for _, size := range []int{30, 40, 60} {
+			for trial := 0; trial < 5; trial++ {
+				random := RandomMatrix(size, size)
+				transposed, _ := random.Transpose()
+				matrix, _ := random.Add(transposed)
+
+				eigen, err := matrix.SymmetricEigen()
+				if err != nil {
+					t.Fatalf("Got an error with a %dx%d matrix while none was expected: %v", size, size, err)
+				}
+
+				values := eigen.Values()
+				vectors := eigen.Vectors()
+				left, _ := matrix.DotProduct(vectors)
+				right := ZeroMatrixFrom(vectors)
+				for i := 0; i < right.Rows(); i++ {
+					for j := 0; j < right.Cols(); j++ {
+						right.SetAt(i, j, vectors.At(i, j)*values[j])
+					}
+				}
+
+				if !almostEqual(left, right, 1e-9) {
+					t.Errorf("Expected A * V to equal V * D with a %dx%d matrix", size, size)
+				}
+			}
+		}
+	})
+
+	t.Run("with a zero diagonal", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{0, 1, 1e-20},
+				Row{1, 0, 1},
+				Row{1e-20, 1, 0},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		eigen, err := matrix.SymmetricEigen()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := []float64{math.Sqrt2, 0, -math.Sqrt2}
+		for i, value := range eigen.Values() {
+			if math.Abs(value-expected[i]) > 1e-12 {
+				t.Errorf("At position %d, expected %f, got %f.", i, expected[i], value)
+			}
+		}
+	})
+
+	t.Run("with a non symmetric matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{3, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.SymmetricEigen()
+		if err == nil {
+			t.Fatalf("Got no error with a non symmetric matrix.")
+		}
+	})
+
+	t.Run("without convergence", func(t *testing.T) {
+		previous := MaxIterations
+		SetMaxIterations(0)
+		defer SetMaxIterations(previous)
+
+		matrix, err := Build(
+			Builder{
+				Row{2, 1},
+				Row{1, 2},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.SymmetricEigen()
+		if err == nil {
+			t.Fatalf("Got no error while convergence failed.")
+		}
+	})
+}
+
+func TestEigenvalues(t *testing.T) {
+	t.Run("with real eigenvalues", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{4, 1},
+				Row{2, 3},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		values, err := matrix.Eigenvalues()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := []complex128{5, 2}
+		for i, value := range values {
+			if cmplx.Abs(value-expected[i]) > 1e-12 {
+				t.Errorf("At position %d, expected %v, got %v.", i, expected[i], value)
+			}
+		}
+	})
+
+	t.Run("with complex eigenvalues", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{0, -1, 0},
+				Row{1, 0, 0},
+				Row{0, 0, 2},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		values, err := matrix.Eigenvalues()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := []complex128{2, 1i, -1i}
+		for i, value := range values {
+			if cmplx.Abs(value-expected[i]) > 1e-12 {
+				t.Errorf("At position %d, expected %v, got %v.", i, expected[i], value)
+			}
+		}
+	})
+
+	t.Run("with a random matrix", func(t *testing.T) {
+		matrix := RandomMatrix(8, 8)
+
+		values, err := matrix.Eigenvalues()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		sum, product := complex(0, 0), complex(1, 0)
+		for _, value := range values {
+			sum += value
+			product *= value
+		}
+
+		trace := 0.0
+		for i := 0; i < matrix.Rows(); i++ {
+			trace += matrix.At(i, i)
+		}
+
+		determinant, err := matrix.Determinant()
+		if err != nil {
+			t.Fatalf("Got an error while computing determinant while none was expected: %v", err)
+		}
+
+		if cmplx.Abs(sum-complex(trace, 0)) > 1e-9 {
+			t.Errorf("Expected eigenvalues sum to be the trace %f, got %v", trace, sum)
+		}
+
+		if cmplx.Abs(product-complex(determinant, 0)) > 1e-9*math.Max(1, math.Abs(determinant)) {
+			t.Errorf("Expected eigenvalues product to be the determinant %f, got %v", determinant, product)
+		}
+	})
+
+	t.Run("with a zero matrix", func(t *testing.T) {
+		for size := 1; size <= 5; size++ {
+			values, err := GenerateMatrix(size, size).Eigenvalues()
+			if err != nil {
+				t.Fatalf("Got an error with a %dx%d zero matrix while none was expected: %v", size, size, err)
+			}
+
+			if len(values) != size {
+				t.Fatalf("Expected %d eigenvalues, got %v", size, values)
+			}
+
+			for i, value := range values {
+				if value != 0 {
+					t.Errorf("At position %d, expected 0, got %v.", i, value)
+				}
+			}
+		}
+	})
+
+	t.Run("with a non square matrix", func(t *testing.T) {
+		_, err := GenerateMatrix(2, 3).Eigenvalues()
+		if err == nil {
+			t.Fatalf("Got no error with a non square matrix.")
+		}
+	})
+
+	t.Run("without convergence", func(t *testing.T) {
+		previous := MaxIterations
+		SetMaxIterations(0)
+		defer SetMaxIterations(previous)
+
+		_, err := RandomMatrix(5, 5).Eigenvalues()
+		if err == nil {
+			t.Fatalf("Got no error while convergence failed.")
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// LaTeX renders matrix as a LaTeX `bmatrix` environment (requires the
+// `amsmath` package):
+//
+//	\begin{bmatrix}
+//	1 & 2.5 \\
+//	-3 & 4 \times 10^{-7}
+//	\end{bmatrix}
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) LaTeX() (output string, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't export matrix %v as LaTeX: matrix is not valid`, matrix))
+		return
+	}
+
+	var builder strings.Builder
+	builder.WriteString("\\begin{bmatrix}\n")
+	for i := 0; i < matrix.Rows(); i++ {
+		for j := 0; j < matrix.Cols(); j++ {
+			if j > 0 {
+				builder.WriteString(" & ")
+			}
+			builder.WriteString(latexNumber(matrix.At(i, j)))
+		}
+
+		if i < matrix.Rows()-1 {
+			builder.WriteString(" \\\\")
+		}
+		builder.WriteString("\n")
+	}
+	builder.WriteString("\\end{bmatrix}")
+
+	output = builder.String()
+	return
+}
+
+// Markdown renders matrix as a Markdown table, which header holds column
+// indexes:
+//
+//	| 0 | 1 |
+//	|---|---|
+//	| 1 | 2.5 |
+//	| -3 | 4e-07 |
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Markdown() (output string, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't export matrix %v as Markdown: matrix is not valid`, matrix))
+		return
+	}
+
+	var builder strings.Builder
+	builder.WriteString("|")
+	for j := 0; j < matrix.Cols(); j++ {
+		fmt.Fprintf(&builder, " %d |", j)
+	}
+
+	builder.WriteString("\n|")
+	for j := 0; j < matrix.Cols(); j++ {
+		builder.WriteString("---|")
+	}
+
+	for i := 0; i < matrix.Rows(); i++ {
+		builder.WriteString("\n|")
+		for j := 0; j < matrix.Cols(); j++ {
+			fmt.Fprintf(&builder, " %s |", strconv.FormatFloat(matrix.At(i, j), 'g', -1, 64))
+		}
+	}
+
+	output = builder.String()
+	return
+}
+
+// GoSource renders matrix as Go source code building the exact same matrix:
+//
+//	matrix.Build(
+//		matrix.Builder{
+//			matrix.Row{1, 2.5},
+//			matrix.Row{-3, 4e-07},
+//		},
+//	)
+//
+// NaN and infinite values are rendered as `math.NaN()` and `math.Inf()`,
+// which requires importing `math`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) GoSource() (output string, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't export matrix %v as Go source: matrix is not valid`, matrix))
+		return
+	}
+
+	var builder strings.Builder
+	builder.WriteString("matrix.Build(\n\tmatrix.Builder{\n")
+	for i := 0; i < matrix.Rows(); i++ {
+		builder.WriteString("\t\tmatrix.Row{")
+		for j := 0; j < matrix.Cols(); j++ {
+			if j > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(goNumber(matrix.At(i, j)))
+		}
+		builder.WriteString("},\n")
+	}
+	builder.WriteString("\t},\n)")
+
+	output = builder.String()
+	return
+}
+
+// latexNumber formats value for LaTeX, using scientific notation with
+// powers of ten when needed.
+func latexNumber(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return `\mathrm{NaN}`
+	case math.IsInf(value, 1):
+		return `\infty`
+	case math.IsInf(value, -1):
+		return `-\infty`
+	}
+
+	formatted := strconv.FormatFloat(value, 'g', -1, 64)
+	mantissa, exponent, scientific := strings.Cut(formatted, "e")
+	if !scientific {
+		return formatted
+	}
+
+	power, _ := strconv.Atoi(exponent)
+	return fmt.Sprintf(`%s \times 10^{%d}`, mantissa, power)
+}
+
+// goNumber formats value as a Go expression.
+func goNumber(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "math.NaN()"
+	case math.IsInf(value, 1):
+		return "math.Inf(1)"
+	case math.IsInf(value, -1):
+		return "math.Inf(-1)"
+	case value == 0 && math.Signbit(value):
+		return "math.Copysign(0, -1)"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
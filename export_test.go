@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestLaTeX(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2.5},
+				Row{-3, 4e-7},
+				Row{math.NaN(), math.Inf(-1)},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.LaTeX()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := "\\begin{bmatrix}\n1 & 2.5 \\\\\n-3 & 4 \\times 10^{-7} \\\\\n\\mathrm{NaN} & -\\infty\n\\end{bmatrix}"
+		if actual != expected {
+			t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).LaTeX()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestMarkdown(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2.5},
+				Row{-3, 4e-7},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.Markdown()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := "| 0 | 1 |\n|---|---|\n| 1 | 2.5 |\n| -3 | 4e-07 |"
+		if actual != expected {
+			t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).Markdown()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestGoSource(t *testing.T) {
+	t.Run("with a round trip", func(t *testing.T) {
+		expected := RandomMatrix(3, 4)
+		expected.SetAt(0, 0, 1e300)
+		expected.SetAt(0, 1, -5e-324)
+
+		source, err := expected.GoSource()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expression, err := parser.ParseExpr(source)
+		if err != nil {
+			t.Fatalf("Generated source does not parse: %v\n%s", err, source)
+		}
+
+		builder := Builder{}
+		ast.Inspect(expression, func(node ast.Node) bool {
+			literal, ok := node.(*ast.CompositeLit)
+			if !ok || types.ExprString(literal.Type) != "matrix.Row" {
+				return true
+			}
+
+			row := Row{}
+			for _, element := range literal.Elts {
+				value, err := types.Eval(token.NewFileSet(), nil, token.NoPos, types.ExprString(element))
+				if err != nil {
+					t.Fatalf("Can't evaluate %s: %v", types.ExprString(element), err)
+				}
+
+				float, _ := constant.Float64Val(value.Value)
+				row = append(row, float)
+			}
+			builder = append(builder, row)
+
+			return false
+		})
+
+		actual, err := Build(builder)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s\nFrom source:\n%s", expected, actual, source)
+		}
+
+		if !strings.HasPrefix(source, "matrix.Build(\n\tmatrix.Builder{\n\t\tmatrix.Row{") {
+			t.Errorf("Unexpected source layout:\n%s", source)
+		}
+	})
+
+	t.Run("with non finite values", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{math.NaN(), math.Inf(1), math.Inf(-1)},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.GoSource()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := "matrix.Build(\n\tmatrix.Builder{\n\t\tmatrix.Row{math.NaN(), math.Inf(1), math.Inf(-1)},\n\t},\n)"
+		if actual != expected {
+			t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).GoSource()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
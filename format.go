@@ -0,0 +1,193 @@
+package matrix
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PrintOptions configures how big matrices are truncated when formatted.
+type PrintOptions struct {
+	// Threshold is the number of cells above which matrix is truncated.
+	Threshold int
+
+	// EdgeItems is the number of rows and cols kept at the beginning and at
+	// the end of each dimension when matrix is truncated.
+	EdgeItems int
+}
+
+var printOptions = PrintOptions{Threshold: 1000, EdgeItems: 3}
+
+/*
+ * Provide how big matrices should be truncated when formatted. Default is to
+ * truncate matrices having more than 1000 cells, keeping 3 rows and cols on
+ * each side.
+ */
+func SetPrintOptions(options PrintOptions) {
+	printOptions = options
+}
+
+// Format implements `fmt.Formatter`, so that matrices can be printed with
+// `fmt.Printf()` and friends:
+//
+//   - `%v` and `%s` output the same thing than `String()`
+//   - `%f`, `%e`, `%g` (and their uppercase versions) output aligned columns,
+//     each cell being formatted with given verb, precision and flags
+//   - `%+v` outputs aligned columns, preceded by matrix dimensions
+//   - the `#` flag outputs matrix on a single line, eg: `%#.2f`
+//   - width is the minimum width of each cell, and the `-` flag aligns cells
+//     to the left
+//
+// Matrices having more cells than the print options threshold are truncated,
+// with ellipses replacing the middle rows and cols (see `SetPrintOptions()`).
+func (matrix Matrix) Format(state fmt.State, verb rune) {
+	_, hasWidth := state.Width()
+	_, hasPrecision := state.Precision()
+	withDimensions := false
+
+	switch verb {
+	case 'v', 's':
+		if !state.Flag('+') && !state.Flag('#') && !hasWidth && !hasPrecision {
+			io.WriteString(state, matrix.String())
+			return
+		}
+		withDimensions = state.Flag('+')
+		verb = 'g'
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+	default:
+		fmt.Fprintf(state, "%%!%c(matrix.Matrix)", verb)
+		return
+	}
+
+	if !matrix.Valid() {
+		io.WriteString(state, "%!(INVALID MATRIX)")
+		return
+	}
+
+	cells, rowIndexes := matrix.formatCells(state, verb, state.Flag('+') && !withDimensions)
+
+	width, _ := state.Width()
+	for _, row := range cells {
+		for _, cell := range row {
+			if len(cell) > width {
+				width = len(cell)
+			}
+		}
+	}
+
+	compact := state.Flag('#')
+	if withDimensions && !compact {
+		fmt.Fprintf(state, "Matrix %dx%d\n", matrix.Rows(), matrix.Cols())
+	}
+
+	var output strings.Builder
+	output.WriteByte('[')
+	for i, row := range cells {
+		if i > 0 {
+			if compact {
+				output.WriteByte(' ')
+			} else {
+				output.WriteString("\n ")
+			}
+		}
+
+		if rowIndexes[i] < 0 {
+			output.WriteString("...")
+			continue
+		}
+
+		output.WriteByte('[')
+		for j, cell := range row {
+			if j > 0 {
+				output.WriteByte(' ')
+			}
+
+			if compact {
+				output.WriteString(cell)
+				continue
+			}
+
+			padding := strings.Repeat(" ", width-len(cell))
+			if state.Flag('-') {
+				output.WriteString(cell + padding)
+			} else {
+				output.WriteString(padding + cell)
+			}
+		}
+		output.WriteByte(']')
+	}
+	output.WriteByte(']')
+
+	io.WriteString(state, output.String())
+}
+
+// formatCells formats the cells to display, along with the indexes of
+// displayed rows, -1 marking an ellipsis. If `signed` is true,
+// non-negative values are prefixed with "+".
+func (matrix Matrix) formatCells(state fmt.State, verb rune, signed bool) (cells [][]string, rowIndexes []int) {
+	precision, hasPrecision := state.Precision()
+	if !hasPrecision {
+		precision = 6
+		if verb == 'g' || verb == 'G' {
+			precision = -1
+		}
+	}
+
+	truncate := printOptions.Threshold >= 0 && matrix.Rows()*matrix.Cols() > printOptions.Threshold
+	rowIndexes = displayedIndexes(matrix.Rows(), truncate)
+	colIndexes := displayedIndexes(matrix.Cols(), truncate)
+
+	cells = make([][]string, len(rowIndexes))
+	for i, row := range rowIndexes {
+		if row < 0 {
+			continue
+		}
+
+		cells[i] = make([]string, len(colIndexes))
+		for j, col := range colIndexes {
+			if col < 0 {
+				cells[i][j] = "..."
+				continue
+			}
+
+			value := matrix.At(row, col)
+			cell := strconv.FormatFloat(value, byte(verb), precision, 64)
+			if value >= 0 && signed {
+				cell = "+" + cell
+			} else if value >= 0 && state.Flag(' ') {
+				cell = " " + cell
+			}
+
+			cells[i][j] = cell
+		}
+	}
+
+	return
+}
+
+// displayedIndexes lists the indexes to display for a dimension of given
+// size, -1 marking an ellipsis.
+func displayedIndexes(size int, truncate bool) (indexes []int) {
+	edge := printOptions.EdgeItems
+	if edge < 1 {
+		edge = 1
+	}
+
+	if !truncate || size <= 2*edge {
+		for i := 0; i < size; i++ {
+			indexes = append(indexes, i)
+		}
+		return
+	}
+
+	for i := 0; i < edge; i++ {
+		indexes = append(indexes, i)
+	}
+	indexes = append(indexes, -1)
+	for i := size - edge; i < size; i++ {
+		indexes = append(indexes, i)
+	}
+
+	return
+}
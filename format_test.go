@@ -0,0 +1,70 @@
+package matrix
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{1, -2.5, 3},
+			Row{40, 5, 6},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	cases := map[string]string{
+		"%v":     matrix.String(),
+		"%s":     matrix.String(),
+		"%.2f":   "[[ 1.00 -2.50  3.00]\n [40.00  5.00  6.00]]",
+		"%+v":    "Matrix 2x3\n[[   1 -2.5    3]\n [  40    5    6]]",
+		"%#v":    "[[1 -2.5 3] [40 5 6]]",
+		"%#.1f":  "[[1.0 -2.5 3.0] [40.0 5.0 6.0]]",
+		"%+.1e":  "[[+1.0e+00 -2.5e+00 +3.0e+00]\n [+4.0e+01 +5.0e+00 +6.0e+00]]",
+		"%6g":    "[[     1   -2.5      3]\n [    40      5      6]]",
+		"%-5.1f": "[[1.0   -2.5  3.0  ]\n [40.0  5.0   6.0  ]]",
+		"%d":     "%!d(matrix.Matrix)",
+	}
+
+	for format, expected := range cases {
+		format, expected := format, expected
+		t.Run(format, func(t *testing.T) {
+			actual := fmt.Sprintf(format, matrix)
+			if actual != expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+			}
+		})
+	}
+
+	t.Run("with a big matrix", func(t *testing.T) {
+		SetPrintOptions(PrintOptions{Threshold: 10, EdgeItems: 1})
+		defer SetPrintOptions(PrintOptions{Threshold: 1000, EdgeItems: 3})
+
+		big := GenerateMatrix(4, 5)
+		big.SetAt(3, 4, 1)
+
+		expected := "[[  0 ...   0]\n ...\n [  0 ...   1]]"
+		actual := fmt.Sprintf("%.0f", big)
+		if actual != expected {
+			t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+		}
+
+		expected = "[[0 ... 0] ... [0 ... 1]]"
+		actual = fmt.Sprintf("%#v", big)
+		if actual != expected {
+			t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+
+		actual := fmt.Sprintf("%.2f", invalid)
+		if actual != "%!(INVALID MATRIX)" {
+			t.Errorf("Expected invalid matrix marker, got %s", actual)
+		}
+	})
+}
@@ -65,3 +65,14 @@ func RandomMatrix(rows, cols int) Matrix {
 
 	return matrix
 }
+
+// IdentityMatrix generates a square matrix of `size` rows and cols, with 1.0
+// on its diagonal and 0.0 everywhere else.
+func IdentityMatrix(size int) Matrix {
+	matrix := GenerateMatrix(size, size)
+	for i := 0; i < size; i++ {
+		matrix.SetAt(i, i, 1)
+	}
+
+	return matrix
+}
@@ -123,3 +123,24 @@ func TestRandomMatrix(t *testing.T) {
 		}
 	}
 }
+
+func TestIdentityMatrix(t *testing.T) {
+	matrix := IdentityMatrix(3)
+
+	if matrix.Rows() != 3 || matrix.Cols() != 3 {
+		t.Fatalf("Expected a 3x3 matrix, got %dx%d", matrix.Rows(), matrix.Cols())
+	}
+
+	for i := 0; i < matrix.Rows(); i++ {
+		for j := 0; j < matrix.Cols(); j++ {
+			expected := 0.0
+			if i == j {
+				expected = 1.0
+			}
+
+			if matrix.At(i, j) != expected {
+				t.Errorf("Expected %f at (%d, %d), got %f", expected, i, j, matrix.At(i, j))
+			}
+		}
+	}
+}
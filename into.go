@@ -0,0 +1,232 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// BinaryOperationInto works like `BinaryOperation()`, but writes its result
+// in destination instead of allocating a new matrix. Destination can be one
+// of the operands.
+//
+// Returns error if any matrix is invalid or if they are not all of the same
+// dimensions.
+func BinaryOperationInto(destination, matrix, otherMatrix Matrix, operation func(float64, float64) float64, operationName string) (err error) {
+	if !matrix.SameDimensions(otherMatrix) || !destination.SameDimensions(matrix) {
+		err = generateError(fmt.Sprintf(`Can't apply operation "%s" on matrices: %v, %v and destination %v are not of the same dimension`, operationName, matrix, otherMatrix, destination))
+		return
+	}
+
+	for i := 2; i < len(matrix); i++ {
+		destination[i] = operation(matrix[i], otherMatrix[i])
+	}
+
+	return
+}
+
+// UnaryOperationInto works like `UnaryOperation()`, but writes its result in
+// destination instead of allocating a new matrix. Destination can be matrix
+// itself.
+//
+// Returns error if any matrix is invalid or if they are not of the same
+// dimensions.
+func UnaryOperationInto(destination, matrix Matrix, operation func(float64) float64, operationName string) (err error) {
+	if !destination.SameDimensions(matrix) {
+		err = generateError(fmt.Sprintf(`Can't apply operation "%s" on matrix %v: destination %v is not valid or not the same dimension`, operationName, matrix, destination))
+		return
+	}
+
+	for i := 2; i < len(matrix); i++ {
+		destination[i] = operation(matrix[i])
+	}
+
+	return
+}
+
+// AddInto adds up matrix and otherMatrix, and writes the result in
+// destination.
+//
+// Returns error if any matrix is invalid or if they are not all of the same
+// dimensions.
+func AddInto(destination, matrix, otherMatrix Matrix) (err error) {
+	err = BinaryOperationInto(destination, matrix, otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 + value2
+	}, "Add")
+	return
+}
+
+// SubstractInto removes otherMatrix from matrix, and writes the result in
+// destination.
+//
+// Returns error if any matrix is invalid or if they are not all of the same
+// dimensions.
+func SubstractInto(destination, matrix, otherMatrix Matrix) (err error) {
+	err = BinaryOperationInto(destination, matrix, otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 - value2
+	}, "Substract")
+	return
+}
+
+// MultiplyCellsInto multiplies each cell from matrix with each cell at the
+// same coordinate in otherMatrix, and writes the result in destination.
+//
+// Returns error if any matrix is invalid or if they are not all of the same
+// dimensions.
+func MultiplyCellsInto(destination, matrix, otherMatrix Matrix) (err error) {
+	err = BinaryOperationInto(destination, matrix, otherMatrix, func(value1 float64, value2 float64) float64 {
+		return value1 * value2
+	}, "MultiplyCells")
+	return
+}
+
+// ScalarMultiplyInto multiplies each cell of matrix with the provided value,
+// and writes the result in destination.
+//
+// Returns error if any matrix is invalid or if they are not of the same
+// dimensions.
+func ScalarMultiplyInto(destination, matrix Matrix, scalar float64) (err error) {
+	err = UnaryOperationInto(destination, matrix, func(value float64) float64 {
+		return value * scalar
+	}, "ScalarMultiply")
+	return
+}
+
+// SigmoidInto applies sigmoid function on each cell of matrix, and writes the
+// result in destination.
+//
+// Returns error if any matrix is invalid or if they are not of the same
+// dimensions.
+func SigmoidInto(destination, matrix Matrix) (err error) {
+	err = UnaryOperationInto(destination, matrix, sigmoid, "Sigmoid")
+	return
+}
+
+// DotProductInto performs a mathematical standard multiplication between
+// matrix and otherMatrix, and writes the result in destination.
+//
+// Destination can't be one of the operands, since they're still read while
+// the result is written.
+//
+// Returns error if product is undefined, if destination does not have the
+// product dimensions or if it shares storage with an operand.
+func DotProductInto(destination, matrix, otherMatrix Matrix) (err error) {
+	if !matrix.Valid() || !otherMatrix.Valid() || matrix[1] != otherMatrix[0] {
+		err = generateError(fmt.Sprintf("Can't multiply matrices: %v columns count do not match %v rows count", matrix, otherMatrix))
+		return
+	}
+
+	if !destination.Valid() || destination[0] != matrix[0] || destination[1] != otherMatrix[1] {
+		err = generateError(fmt.Sprintf("Can't multiply matrices: destination %v is not a %vx%v matrix", destination, matrix[0], otherMatrix[1]))
+		return
+	}
+
+	if sameStorage(destination, matrix) || sameStorage(destination, otherMatrix) {
+		err = generateError("Can't multiply matrices: destination can't be one of the operands")
+		return
+	}
+
+	for i := 2; i < len(destination); i++ {
+		destination[i] = 0
+	}
+	multiplyTiles(destination, matrix, otherMatrix)
+
+	return
+}
+
+// TransposeInto writes the transposition of matrix in destination.
+//
+// Destination can't be matrix itself, since it's still read while the result
+// is written.
+//
+// Returns error if any matrix is invalid, if destination dimensions are not
+// the transposed ones or if it shares storage with matrix.
+func TransposeInto(destination, matrix Matrix) (err error) {
+	if !matrix.Valid() || !destination.Valid() || destination[0] != matrix[1] || destination[1] != matrix[0] {
+		err = generateError(fmt.Sprintf(`Can't transpose matrix %v: matrix is not valid or destination %v has not transposed dimensions`, matrix, destination))
+		return
+	}
+
+	if sameStorage(destination, matrix) {
+		err = generateError("Can't transpose matrix: destination can't be the transposed matrix")
+		return
+	}
+
+	for i := 0; i < int(matrix[1]); i++ {
+		for j := 0; j < int(matrix[0]); j++ {
+			destination[destination.IndexFor(i, j)] = matrix.At(j, i)
+		}
+	}
+
+	return
+}
+
+// BinaryOperationInPlace applies `operation` cell by cell on matrix and
+// otherMatrix, and stores the result in matrix.
+//
+// Returns error if both matrices aren't of same dimensions.
+func (matrix Matrix) BinaryOperationInPlace(otherMatrix Matrix, operation func(float64, float64) float64, operationName string) (err error) {
+	err = BinaryOperationInto(matrix, matrix, otherMatrix, operation, operationName)
+	return
+}
+
+// UnaryOperationInPlace applies `operation` on each cell of matrix, and
+// stores the result in matrix.
+//
+// Returns error if matrix is invalid.
+func (matrix Matrix) UnaryOperationInPlace(operation func(float64) float64, operationName string) (err error) {
+	err = UnaryOperationInto(matrix, matrix, operation, operationName)
+	return
+}
+
+// AddInPlace adds up otherMatrix to matrix, modifying matrix.
+//
+// Returns error if matrices are not valid or do not have the same dimensions.
+func (matrix Matrix) AddInPlace(otherMatrix Matrix) (err error) {
+	err = AddInto(matrix, matrix, otherMatrix)
+	return
+}
+
+// SubstractInPlace removes otherMatrix from matrix, modifying matrix.
+//
+// Returns error if matrices are not valid or do not have the same dimensions.
+func (matrix Matrix) SubstractInPlace(otherMatrix Matrix) (err error) {
+	err = SubstractInto(matrix, matrix, otherMatrix)
+	return
+}
+
+// MultiplyCellsInPlace multiplies each cell from matrix with each cell at the
+// same coordinate in otherMatrix, modifying matrix.
+//
+// Returns error if matrices are not valid or do not have the same dimensions.
+func (matrix Matrix) MultiplyCellsInPlace(otherMatrix Matrix) (err error) {
+	err = MultiplyCellsInto(matrix, matrix, otherMatrix)
+	return
+}
+
+// ScalarMultiplyInPlace multiplies each cell of matrix with the provided
+// value, modifying matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ScalarMultiplyInPlace(scalar float64) (err error) {
+	err = ScalarMultiplyInto(matrix, matrix, scalar)
+	return
+}
+
+// SigmoidInPlace applies sigmoid function on each cell of matrix, modifying
+// matrix.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) SigmoidInPlace() (err error) {
+	err = SigmoidInto(matrix, matrix)
+	return
+}
+
+// sigmoid is the sigmoid function, applied on a single value.
+func sigmoid(value float64) float64 {
+	return 1.0 / (1.0 + math.Exp(-value))
+}
+
+// sameStorage tells if both matrices use the same underlying array.
+func sameStorage(matrix, otherMatrix Matrix) bool {
+	return len(matrix) > 0 && len(otherMatrix) > 0 && &matrix[0] == &otherMatrix[0]
+}
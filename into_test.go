@@ -0,0 +1,293 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestBinaryOperationInto(t *testing.T) {
+	matrix1, err := Build(Builder{Row{1, 2}, Row{3, 4}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix1 while none was expected: %v", err)
+	}
+
+	matrix2, err := Build(Builder{Row{5, 6}, Row{7, 8}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix2 while none was expected: %v", err)
+	}
+
+	t.Run("with a separate destination", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		err := AddInto(destination, matrix1, matrix2)
+		if err != nil {
+			t.Fatalf("Got an error while adding matrices while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{6, 8}, Row{10, 12}})
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("with operand as destination", func(t *testing.T) {
+		destination := Matrix(append([]float64{}, matrix1...))
+		err := SubstractInto(destination, destination, matrix2)
+		if err != nil {
+			t.Fatalf("Got an error while substracting matrices while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{-4, -4}, Row{-4, -4}})
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("multiplying cells", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		err := MultiplyCellsInto(destination, matrix1, matrix2)
+		if err != nil {
+			t.Fatalf("Got an error while multiplying cells while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{5, 12}, Row{21, 32}})
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("with a destination of wrong dimensions", func(t *testing.T) {
+		destination := GenerateMatrix(3, 2)
+		err := AddInto(destination, matrix1, matrix2)
+		if err == nil {
+			t.Fatalf("Got no error from destination of wrong dimensions")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		err := AddInto(destination, matrix1, Matrix([]float64{10, 10, 1}))
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+
+	t.Run("without allocating", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		allocations := testing.AllocsPerRun(100, func() {
+			AddInto(destination, matrix1, matrix2)
+		})
+		if allocations != 0 {
+			t.Errorf("Expected no allocation, got %v", allocations)
+		}
+	})
+}
+
+func TestUnaryOperationInto(t *testing.T) {
+	matrix, err := Build(Builder{Row{1, 2}, Row{3, 4}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("scaling into a destination", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		err := ScalarMultiplyInto(destination, matrix, 3)
+		if err != nil {
+			t.Fatalf("Got an error while multiplying matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{3, 6}, Row{9, 12}})
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("applying sigmoid into a destination", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		err := SigmoidInto(destination, matrix)
+		if err != nil {
+			t.Fatalf("Got an error while applying sigmoid while none was expected: %v", err)
+		}
+
+		expected, _ := matrix.Sigmoid()
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("with a destination of wrong dimensions", func(t *testing.T) {
+		destination := GenerateMatrix(2, 3)
+		err := UnaryOperationInto(destination, matrix, func(value float64) float64 { return value }, "Identity")
+		if err == nil {
+			t.Fatalf("Got no error from destination of wrong dimensions")
+		}
+	})
+
+	t.Run("without allocating", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		allocations := testing.AllocsPerRun(100, func() {
+			ScalarMultiplyInto(destination, matrix, 2)
+		})
+		if allocations != 0 {
+			t.Errorf("Expected no allocation, got %v", allocations)
+		}
+	})
+}
+
+func TestDotProductInto(t *testing.T) {
+	matrix1, err := Build(Builder{Row{1, 2, 3}, Row{4, 5, 6}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix1 while none was expected: %v", err)
+	}
+
+	matrix2, err := Build(Builder{Row{7, 8}, Row{9, 10}, Row{11, 12}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix2 while none was expected: %v", err)
+	}
+
+	t.Run("with a reused destination", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		for i := 0; i < 2; i++ {
+			err := DotProductInto(destination, matrix1, matrix2)
+			if err != nil {
+				t.Fatalf("Got an error while multiplying matrices while none was expected: %v", err)
+			}
+		}
+
+		expected, _ := Build(Builder{Row{58, 64}, Row{139, 154}})
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("with a destination of wrong dimensions", func(t *testing.T) {
+		destination := GenerateMatrix(3, 3)
+		err := DotProductInto(destination, matrix1, matrix2)
+		if err == nil {
+			t.Fatalf("Got no error from destination of wrong dimensions")
+		}
+	})
+
+	t.Run("with an operand as destination", func(t *testing.T) {
+		square, _ := Build(Builder{Row{1, 2}, Row{3, 4}})
+		err := DotProductInto(square, square, square)
+		if err == nil {
+			t.Fatalf("Got no error from operand used as destination")
+		}
+	})
+
+	t.Run("with undefined product", func(t *testing.T) {
+		destination := GenerateMatrix(2, 3)
+		err := DotProductInto(destination, matrix1, matrix1)
+		if err == nil {
+			t.Fatalf("Got no error from undefined product")
+		}
+	})
+
+	t.Run("without allocating", func(t *testing.T) {
+		destination := GenerateMatrix(2, 2)
+		allocations := testing.AllocsPerRun(100, func() {
+			DotProductInto(destination, matrix1, matrix2)
+		})
+		if allocations != 0 {
+			t.Errorf("Expected no allocation, got %v", allocations)
+		}
+	})
+}
+
+func TestTransposeInto(t *testing.T) {
+	matrix, err := Build(Builder{Row{1, 2, 3}, Row{4, 5, 6}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("with a valid destination", func(t *testing.T) {
+		destination := GenerateMatrix(3, 2)
+		err := TransposeInto(destination, matrix)
+		if err != nil {
+			t.Fatalf("Got an error while transposing matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{1, 4}, Row{2, 5}, Row{3, 6}})
+		if !destination.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, destination)
+		}
+	})
+
+	t.Run("with a destination of wrong dimensions", func(t *testing.T) {
+		destination := GenerateMatrix(2, 3)
+		err := TransposeInto(destination, matrix)
+		if err == nil {
+			t.Fatalf("Got no error from destination of wrong dimensions")
+		}
+	})
+
+	t.Run("with matrix as destination", func(t *testing.T) {
+		square, _ := Build(Builder{Row{1, 2}, Row{3, 4}})
+		err := TransposeInto(square, square)
+		if err == nil {
+			t.Fatalf("Got no error from matrix used as destination")
+		}
+	})
+}
+
+func TestInPlaceOperations(t *testing.T) {
+	other, err := Build(Builder{Row{1, 2}, Row{3, 4}})
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("chaining mutators", func(t *testing.T) {
+		matrix, _ := Build(Builder{Row{1, 1}, Row{1, 1}})
+
+		if err := matrix.AddInPlace(other); err != nil {
+			t.Fatalf("Got an error while adding matrix while none was expected: %v", err)
+		}
+		if err := matrix.MultiplyCellsInPlace(other); err != nil {
+			t.Fatalf("Got an error while multiplying cells while none was expected: %v", err)
+		}
+		if err := matrix.SubstractInPlace(other); err != nil {
+			t.Fatalf("Got an error while substracting matrix while none was expected: %v", err)
+		}
+		if err := matrix.ScalarMultiplyInPlace(2); err != nil {
+			t.Fatalf("Got an error while multiplying matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{2, 8}, Row{18, 32}})
+		if !matrix.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, matrix)
+		}
+	})
+
+	t.Run("applying unary operations", func(t *testing.T) {
+		matrix, _ := Build(Builder{Row{0, 0}, Row{0, 0}})
+
+		if err := matrix.SigmoidInPlace(); err != nil {
+			t.Fatalf("Got an error while applying sigmoid while none was expected: %v", err)
+		}
+
+		err := matrix.UnaryOperationInPlace(func(value float64) float64 { return value * 4 }, "Quadruple")
+		if err != nil {
+			t.Fatalf("Got an error while applying operation while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{2, 2}, Row{2, 2}})
+		if !matrix.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, matrix)
+		}
+	})
+
+	t.Run("with matrices of different dimensions", func(t *testing.T) {
+		matrix := GenerateMatrix(3, 2)
+		err := matrix.BinaryOperationInPlace(other, func(value1, value2 float64) float64 { return value1 }, "First")
+		if err == nil {
+			t.Fatalf("Got no error from matrices of different dimensions")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+		err := matrix.ScalarMultiplyInPlace(2)
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
@@ -0,0 +1,234 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NonFinitePolicy tells how NaN and infinite values are handled when
+// encoding or decoding JSON, since JSON numbers can't represent them.
+type NonFinitePolicy int
+
+const (
+	// NonFiniteError returns an error when a NaN or infinite value is met.
+	NonFiniteError NonFinitePolicy = iota
+
+	// NonFiniteAllow encodes NaN and infinite values as the strings "NaN",
+	// "+Inf" and "-Inf". When decoding, those strings (as well as "Inf",
+	// "Infinity", "+Infinity" and "-Infinity") are accepted, and `null` is
+	// decoded as NaN.
+	NonFiniteAllow
+
+	// NonFiniteZero replaces NaN and infinite values with 0.
+	NonFiniteZero
+)
+
+// JSONOptions configures JSON encoding and decoding.
+type JSONOptions struct {
+	NonFinite NonFinitePolicy
+}
+
+// jsonMatrix is the JSON representation of a matrix. `Data` has the same
+// shape than `Builder`.
+type jsonMatrix struct {
+	Rows *int                `json:"rows"`
+	Cols *int                `json:"cols"`
+	Data [][]json.RawMessage `json:"data"`
+}
+
+// MarshalJSON encodes matrix as an object holding its dimensions and its rows:
+//
+//	{"rows":2,"cols":3,"data":[[1,2,3],[4,5,6]]}
+//
+// It implements `json.Marshaler`. A nil matrix is encoded as `null`.
+//
+// Error is returned if matrix is not valid or contains NaN or infinite values.
+func (matrix Matrix) MarshalJSON() (data []byte, err error) {
+	data, err = matrix.MarshalJSONWithOptions(JSONOptions{})
+	return
+}
+
+// MarshalJSONWithOptions encodes matrix just like `MarshalJSON()`, but lets
+// you decide how NaN and infinite values are handled.
+//
+// Error is returned if matrix is not valid, or if it contains NaN or
+// infinite values and `options.NonFinite` is `NonFiniteError`.
+func (matrix Matrix) MarshalJSONWithOptions(options JSONOptions) (data []byte, err error) {
+	if matrix == nil {
+		data = []byte("null")
+		return
+	}
+
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't encode matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	data = append(data, `{"rows":`...)
+	data = strconv.AppendInt(data, int64(matrix.Rows()), 10)
+	data = append(data, `,"cols":`...)
+	data = strconv.AppendInt(data, int64(matrix.Cols()), 10)
+	data = append(data, `,"data":[`...)
+
+	for i := 0; i < matrix.Rows(); i++ {
+		if i > 0 {
+			data = append(data, ',')
+		}
+		data = append(data, '[')
+
+		for j := 0; j < matrix.Cols(); j++ {
+			if j > 0 {
+				data = append(data, ',')
+			}
+
+			value := matrix.At(i, j)
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				switch options.NonFinite {
+				case NonFiniteAllow:
+					data = strconv.AppendQuote(data, strconv.FormatFloat(value, 'g', -1, 64))
+					continue
+				case NonFiniteZero:
+					value = 0
+				default:
+					err = generateError(fmt.Sprintf(`Can't encode matrix: unsupported value %v at (%d, %d)`, value, i, j))
+					data = nil
+					return
+				}
+			}
+
+			data = strconv.AppendFloat(data, value, 'g', -1, 64)
+		}
+
+		data = append(data, ']')
+	}
+
+	data = append(data, "]}"...)
+	return
+}
+
+// UnmarshalJSON decodes a matrix encoded by `MarshalJSON()` and stores it in
+// matrix. It implements `json.Unmarshaler`. Decoding `null` leaves matrix
+// untouched.
+//
+// Error is returned if data is not a valid encoded matrix: dimensions must be
+// provided and match data, all rows must have the same length, and NaN or
+// infinite values are rejected.
+func (matrix *Matrix) UnmarshalJSON(data []byte) (err error) {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return
+	}
+
+	resultMatrix, err := UnmarshalJSONWithOptions(data, JSONOptions{})
+	if err != nil {
+		return
+	}
+
+	*matrix = resultMatrix
+	return
+}
+
+// UnmarshalJSONWithOptions decodes a matrix just like `UnmarshalJSON()`, but
+// lets you decide how NaN and infinite values are handled.
+//
+// Error is returned if data is not a valid encoded matrix, if anything
+// follows the matrix, or if it contains NaN or infinite values and
+// `options.NonFinite` is `NonFiniteError`.
+func UnmarshalJSONWithOptions(data []byte, options JSONOptions) (resultMatrix Matrix, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var decoded jsonMatrix
+	err = decoder.Decode(&decoded)
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: %v`, err))
+		return
+	}
+
+	if _, trailing := decoder.Token(); trailing != io.EOF {
+		err = generateError(`Can't decode matrix: unexpected data after matrix`)
+		return
+	}
+
+	if decoded.Rows == nil || decoded.Cols == nil || decoded.Data == nil {
+		err = generateError(`Can't decode matrix: "rows", "cols" and "data" are all required`)
+		return
+	}
+
+	rows, cols := *decoded.Rows, *decoded.Cols
+	if rows < 1 || cols < 1 || len(decoded.Data) != rows {
+		err = generateError(fmt.Sprintf(`Can't decode matrix: %d rows provided for %dx%d dimensions`, len(decoded.Data), rows, cols))
+		return
+	}
+
+	// Rows are checked before allocating, so that dimensions can't announce
+	// more cells than data actually provides.
+	for i, row := range decoded.Data {
+		if len(row) != cols {
+			err = generateError(fmt.Sprintf(`Can't decode matrix: row %d has %d columns instead of %d`, i, len(row), cols))
+			return
+		}
+	}
+
+	resultMatrix = GenerateMatrix(rows, cols)
+	for i, row := range decoded.Data {
+		for j, cell := range row {
+			var value float64
+			value, err = decodeJSONValue(cell, options)
+			if err != nil {
+				err = generateError(fmt.Sprintf(`Can't decode matrix: at (%d, %d): %v`, i, j, err))
+				resultMatrix = nil
+				return
+			}
+
+			resultMatrix.SetAt(i, j, value)
+		}
+	}
+
+	return
+}
+
+// decodeJSONValue decodes a single cell, following NaN and infinite values
+// policy.
+func decodeJSONValue(cell json.RawMessage, options JSONOptions) (value float64, err error) {
+	err = json.Unmarshal(cell, &value)
+	if err == nil && string(bytes.TrimSpace(cell)) != "null" {
+		return
+	}
+
+	value = math.NaN()
+	if err != nil {
+		var text string
+		if json.Unmarshal(cell, &text) != nil {
+			err = fmt.Errorf("%s is not a number", cell)
+			return
+		}
+
+		switch strings.ToLower(text) {
+		case "nan":
+		case "inf", "+inf", "infinity", "+infinity":
+			value = math.Inf(1)
+		case "-inf", "-infinity":
+			value = math.Inf(-1)
+		default:
+			err = fmt.Errorf("%s is not a number", cell)
+			return
+		}
+	}
+
+	switch options.NonFinite {
+	case NonFiniteAllow:
+		err = nil
+	case NonFiniteZero:
+		value = 0
+		err = nil
+	default:
+		err = fmt.Errorf("%s is not a finite number", cell)
+	}
+
+	return
+}
@@ -0,0 +1,204 @@
+package matrix
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2.5, 3},
+				Row{-4, 5, 6e-7},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		actual, err := json.Marshal(matrix)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := `{"rows":2,"cols":3,"data":[[1,2.5,3],[-4,5,6e-07]]}`
+		if string(actual) != expected {
+			t.Errorf("Expected %s, got %s", expected, actual)
+		}
+	})
+
+	t.Run("with builder compatibility", func(t *testing.T) {
+		matrix := RandomMatrix(3, 2)
+		data, err := json.Marshal(matrix)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		var decoded struct {
+			Data Builder `json:"data"`
+		}
+		err = json.Unmarshal(data, &decoded)
+		if err != nil {
+			t.Fatalf("Got an error while decoding builder while none was expected: %v", err)
+		}
+
+		actual, err := Build(decoded.Data)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(matrix) {
+			t.Errorf("Expected :%s\nGot:%s", matrix, actual)
+		}
+	})
+
+	t.Run("with non finite values", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{math.NaN(), math.Inf(1), math.Inf(-1)},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.MarshalJSON()
+		if err == nil {
+			t.Errorf("Got no error with non finite values.")
+		}
+
+		actual, err := matrix.MarshalJSONWithOptions(JSONOptions{NonFinite: NonFiniteAllow})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := `{"rows":1,"cols":3,"data":[["NaN","+Inf","-Inf"]]}`
+		if string(actual) != expected {
+			t.Errorf("Expected %s, got %s", expected, actual)
+		}
+
+		actual, err = matrix.MarshalJSONWithOptions(JSONOptions{NonFinite: NonFiniteZero})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected = `{"rows":1,"cols":3,"data":[[0,0,0]]}`
+		if string(actual) != expected {
+			t.Errorf("Expected %s, got %s", expected, actual)
+		}
+	})
+
+	t.Run("with a nil matrix", func(t *testing.T) {
+		actual, err := json.Marshal(struct{ Weights Matrix }{})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if string(actual) != `{"Weights":null}` {
+			t.Errorf("Expected nil matrix to be encoded as null, got %s", actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.MarshalJSON()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		expected := RandomMatrix(4, 3)
+		data, err := json.Marshal(expected)
+		if err != nil {
+			t.Fatalf("Got an error while encoding while none was expected: %v", err)
+		}
+
+		var actual Matrix
+		err = json.Unmarshal(data, &actual)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with null", func(t *testing.T) {
+		var actual Matrix
+		err := json.Unmarshal([]byte(`null`), &actual)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if actual != nil {
+			t.Errorf("Expected matrix to be left untouched, got %s", actual)
+		}
+	})
+
+	invalids := map[string]string{
+		"with ragged rows":          `{"rows":2,"cols":2,"data":[[1,2],[3]]}`,
+		"with mismatching rows":     `{"rows":3,"cols":2,"data":[[1,2],[3,4]]}`,
+		"with missing dimensions":   `{"data":[[1,2],[3,4]]}`,
+		"with empty data":           `{"rows":0,"cols":0,"data":[]}`,
+		"with unknown fields":       `{"rows":1,"cols":1,"data":[[1]],"foo":1}`,
+		"with a non numeric value":  `{"rows":1,"cols":1,"data":[["foo"]]}`,
+		"with a legacy flat matrix": `[2,2,1,2,3,4]`,
+		"with a NaN value":          `{"rows":1,"cols":1,"data":[["NaN"]]}`,
+		"with a null value":         `{"rows":1,"cols":1,"data":[[null]]}`,
+		"with trailing data":        `{"rows":1,"cols":1,"data":[[1]]} {"garbage":true}`,
+		"with a trailing value":     `{"rows":1,"cols":1,"data":[[1]]} 1`,
+		"with huge dimensions":      `{"rows":1,"cols":1099511627776,"data":[[1]]}`,
+	}
+
+	for name, data := range invalids {
+		data := data
+		t.Run(name, func(t *testing.T) {
+			var actual Matrix
+			err := json.Unmarshal([]byte(data), &actual)
+			if err == nil {
+				t.Fatalf("Got no error with invalid data: %s", data)
+			}
+
+			_, err = UnmarshalJSONWithOptions([]byte(data), JSONOptions{})
+			if err == nil {
+				t.Fatalf("Got no error with invalid data when decoding with options: %s", data)
+			}
+		})
+	}
+
+	t.Run("with trailing whitespace", func(t *testing.T) {
+		_, err := UnmarshalJSONWithOptions([]byte("{\"rows\":1,\"cols\":1,\"data\":[[1]]}\n  "), JSONOptions{})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+	})
+
+	t.Run("with non finite values", func(t *testing.T) {
+		data := []byte(`{"rows":1,"cols":4,"data":[["NaN","Infinity","-Inf",null]]}`)
+
+		actual, err := UnmarshalJSONWithOptions(data, JSONOptions{NonFinite: NonFiniteAllow})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !math.IsNaN(actual.At(0, 0)) || !math.IsInf(actual.At(0, 1), 1) || !math.IsInf(actual.At(0, 2), -1) || !math.IsNaN(actual.At(0, 3)) {
+			t.Errorf("Expected NaN, +Inf, -Inf and NaN, got %s", actual)
+		}
+
+		actual, err = UnmarshalJSONWithOptions(data, JSONOptions{NonFinite: NonFiniteZero})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(GenerateMatrix(1, 4)) {
+			t.Errorf("Expected zeros, got %s", actual)
+		}
+	})
+}
@@ -0,0 +1,189 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// probabilityEpsilon is the smallest distance to 0 and 1 at which
+// probabilities are clipped by cross-entropy losses, to avoid computing
+// the logarithm of 0.
+const probabilityEpsilon = 1e-12
+
+// MeanSquaredError computes the mean of squared differences between
+// predictions (matrix) and targets, and its gradient with regard to
+// predictions.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) MeanSquaredError(targets Matrix) (loss float64, gradient Matrix, err error) {
+	loss, gradient, err = matrix.cellLoss(targets, "MeanSquaredError", false, func(prediction, target float64) (float64, float64) {
+		difference := prediction - target
+		return difference * difference, 2 * difference
+	})
+	return
+}
+
+// MeanAbsoluteError computes the mean of absolute differences between
+// predictions (matrix) and targets, and its gradient with regard to
+// predictions. Gradient is 0 where prediction equals target.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) MeanAbsoluteError(targets Matrix) (loss float64, gradient Matrix, err error) {
+	loss, gradient, err = matrix.cellLoss(targets, "MeanAbsoluteError", false, func(prediction, target float64) (float64, float64) {
+		difference := prediction - target
+		return math.Abs(difference), sign(difference)
+	})
+	return
+}
+
+// HuberLoss computes the mean Huber loss between predictions (matrix) and
+// targets, and its gradient with regard to predictions. Differences smaller
+// than `delta` are squared like with `MeanSquaredError()`, bigger ones grow
+// linearly like with `MeanAbsoluteError()`, making loss less sensitive to
+// outliers.
+//
+// Returns error if any matrix is invalid, both matrices aren't of same
+// dimensions or delta is not positive.
+func (matrix Matrix) HuberLoss(targets Matrix, delta float64) (loss float64, gradient Matrix, err error) {
+	if !(delta > 0) {
+		err = generateError(fmt.Sprintf("Can't compute HuberLoss: delta %v is not positive", delta))
+		return
+	}
+
+	loss, gradient, err = matrix.cellLoss(targets, "HuberLoss", false, func(prediction, target float64) (float64, float64) {
+		difference := prediction - target
+		if math.Abs(difference) <= delta {
+			return 0.5 * difference * difference, difference
+		}
+
+		return delta * (math.Abs(difference) - 0.5*delta), delta * sign(difference)
+	})
+	return
+}
+
+// BinaryCrossEntropy computes the mean binary cross-entropy between
+// predicted probabilities (matrix) and targets, and its gradient with regard
+// to predictions.
+//
+// Predictions are clipped to avoid computing the logarithm of 0. Prefer
+// `BinaryCrossEntropyWithLogits()` when predictions come from `Sigmoid()`,
+// it's more stable.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) BinaryCrossEntropy(targets Matrix) (loss float64, gradient Matrix, err error) {
+	loss, gradient, err = matrix.cellLoss(targets, "BinaryCrossEntropy", false, func(prediction, target float64) (float64, float64) {
+		prediction = clipProbability(prediction)
+		value := -(target*math.Log(prediction) + (1-target)*math.Log(1-prediction))
+		return value, (prediction - target) / (prediction * (1 - prediction))
+	})
+	return
+}
+
+// BinaryCrossEntropyWithLogits computes the mean binary cross-entropy
+// between the sigmoid of logits (matrix) and targets, and its gradient with
+// regard to logits.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) BinaryCrossEntropyWithLogits(targets Matrix) (loss float64, gradient Matrix, err error) {
+	loss, gradient, err = matrix.cellLoss(targets, "BinaryCrossEntropyWithLogits", false, func(logit, target float64) (float64, float64) {
+		return softplus(logit) - logit*target, sigmoid(logit) - target
+	})
+	return
+}
+
+// CategoricalCrossEntropy computes the cross-entropy between each row of
+// predicted probabilities (matrix) and each row of targets, averaged over
+// rows, and its gradient with regard to predictions.
+//
+// Predictions are clipped to avoid computing the logarithm of 0. Prefer
+// `CategoricalCrossEntropyWithLogits()` when predictions come from
+// `Softmax()`, it's more stable.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) CategoricalCrossEntropy(targets Matrix) (loss float64, gradient Matrix, err error) {
+	loss, gradient, err = matrix.cellLoss(targets, "CategoricalCrossEntropy", true, func(prediction, target float64) (float64, float64) {
+		prediction = clipProbability(prediction)
+		return -target * math.Log(prediction), -target / prediction
+	})
+	return
+}
+
+// CategoricalCrossEntropyWithLogits computes the cross-entropy between the
+// softmax of each row of logits (matrix) and each row of targets, averaged
+// over rows, and its gradient with regard to logits.
+//
+// Returns error if any matrix is invalid, or both matrices aren't of same
+// dimensions.
+func (matrix Matrix) CategoricalCrossEntropyWithLogits(targets Matrix) (loss float64, gradient Matrix, err error) {
+	if !matrix.SameDimensions(targets) {
+		err = generateError(fmt.Sprintf("Can't compute CategoricalCrossEntropyWithLogits: predictions %v and targets %v are not valid or not of the same dimension", matrix, targets))
+		return
+	}
+
+	logSoftmax, err := matrix.LogSoftmax()
+	if err != nil {
+		return
+	}
+
+	loss, _, err = logSoftmax.cellLoss(targets, "CategoricalCrossEntropyWithLogits", true, func(logProbability, target float64) (float64, float64) {
+		return -target * logProbability, 0
+	})
+	if err != nil {
+		return
+	}
+
+	// This is LogSoftmaxBackward() applied on the gradient of the loss with
+	// regard to logSoftmax, which is -targets / rows.
+	gradient, _ = targets.ScalarMultiply(-1 / float64(matrix.Rows()))
+	gradient, err = matrix.LogSoftmaxBackward(gradient)
+
+	return
+}
+
+// cellLoss computes the loss and gradient of each cell with `operation`,
+// then averages them over cells, or over rows if `perRow` is true.
+func (matrix Matrix) cellLoss(targets Matrix, lossName string, perRow bool, operation func(prediction, target float64) (loss, gradient float64)) (loss float64, gradient Matrix, err error) {
+	if !matrix.SameDimensions(targets) {
+		err = generateError(fmt.Sprintf("Can't compute %s: predictions %v and targets %v are not valid or not of the same dimension", lossName, matrix, targets))
+		return
+	}
+
+	count := float64(matrix.Rows() * matrix.Cols())
+	if perRow {
+		count = float64(matrix.Rows())
+	}
+
+	gradient = ZeroMatrixFrom(matrix)
+	for i := 2; i < len(matrix); i++ {
+		value, cellGradient := operation(matrix[i], targets[i])
+		loss += value
+		gradient[i] = cellGradient / count
+	}
+
+	loss /= count
+
+	return
+}
+
+// clipProbability keeps probability at probabilityEpsilon distance from 0
+// and 1.
+func clipProbability(probability float64) float64 {
+	return math.Min(math.Max(probability, probabilityEpsilon), 1-probabilityEpsilon)
+}
+
+// sign returns -1, 0 or 1 depending on the sign of value.
+func sign(value float64) float64 {
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	}
+
+	return 0
+}
@@ -0,0 +1,157 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+type lossFunction func(predictions, targets Matrix) (float64, Matrix, error)
+
+// numericalLossGradient computes gradient of loss with regard to predictions
+// with finite differences.
+func numericalLossGradient(loss lossFunction, predictions, targets Matrix) Matrix {
+	step := 1e-6
+	gradient := ZeroMatrixFrom(predictions)
+	for i := 2; i < len(predictions); i++ {
+		original := predictions[i]
+		predictions[i] = original + step
+		above, _, _ := loss(predictions, targets)
+		predictions[i] = original - step
+		below, _, _ := loss(predictions, targets)
+		predictions[i] = original
+		gradient[i] = (above - below) / (2 * step)
+	}
+
+	return gradient
+}
+
+func TestLosses(t *testing.T) {
+	predictions, err := Build(
+		Builder{
+			Row{0.2, 0.7, 0.1},
+			Row{0.3, 0.3, 0.4},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building predictions while none was expected: %v", err)
+	}
+
+	targets, err := Build(
+		Builder{
+			Row{0, 1, 0},
+			Row{0, 0, 1},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building targets while none was expected: %v", err)
+	}
+
+	losses := []struct {
+		name     string
+		loss     lossFunction
+		expected float64
+	}{
+		{"MeanSquaredError", Matrix.MeanSquaredError, (0.04 + 0.09 + 0.01 + 0.09 + 0.09 + 0.36) / 6},
+		{"MeanAbsoluteError", Matrix.MeanAbsoluteError, (0.2 + 0.3 + 0.1 + 0.3 + 0.3 + 0.6) / 6},
+		{"HuberLoss", func(predictions, targets Matrix) (float64, Matrix, error) {
+			return predictions.HuberLoss(targets, 0.35)
+		}, (0.02 + 0.045 + 0.005 + 0.045 + 0.045 + 0.35*(0.6-0.175)) / 6},
+		{"BinaryCrossEntropy", Matrix.BinaryCrossEntropy, -(math.Log(0.8) + math.Log(0.7) + math.Log(0.9) + 2*math.Log(0.7) + math.Log(0.4)) / 6},
+		{"CategoricalCrossEntropy", Matrix.CategoricalCrossEntropy, -(math.Log(0.7) + math.Log(0.4)) / 2},
+	}
+
+	for _, loss := range losses {
+		t.Run("computing "+loss.name, func(t *testing.T) {
+			value, gradient, err := loss.loss(predictions, targets)
+			if err != nil {
+				t.Fatalf("Got an error while computing loss while none was expected: %v", err)
+			}
+
+			if math.Abs(value-loss.expected) > 1e-12 {
+				t.Errorf("Expected loss %v, got %v", loss.expected, value)
+			}
+
+			expected := numericalLossGradient(loss.loss, predictions, targets)
+			if !almostEqual(gradient, expected, 1e-5) {
+				t.Errorf("Expected gradient :%s\nGot:%s", expected, gradient)
+			}
+		})
+	}
+
+	t.Run("with wrong dimensions", func(t *testing.T) {
+		for _, loss := range losses {
+			_, _, err := loss.loss(predictions, GenerateMatrix(3, 2))
+			if err == nil {
+				t.Errorf("Got no error from %s with targets of wrong dimensions", loss.name)
+			}
+		}
+	})
+
+	t.Run("with invalid delta", func(t *testing.T) {
+		_, _, err := predictions.HuberLoss(targets, 0)
+		if err == nil {
+			t.Fatalf("Got no error from invalid delta")
+		}
+	})
+}
+
+func TestLossesWithLogits(t *testing.T) {
+	logits, err := Build(
+		Builder{
+			Row{-1.5, 2, 0.3},
+			Row{800, -800, 0},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building logits while none was expected: %v", err)
+	}
+
+	targets, err := Build(
+		Builder{
+			Row{0, 1, 0},
+			Row{1, 0, 0},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building targets while none was expected: %v", err)
+	}
+
+	losses := []struct {
+		name        string
+		withLogits  lossFunction
+		activation  func(Matrix) (Matrix, error)
+		probability lossFunction
+	}{
+		{"BinaryCrossEntropyWithLogits", Matrix.BinaryCrossEntropyWithLogits, Matrix.Sigmoid, Matrix.BinaryCrossEntropy},
+		{"CategoricalCrossEntropyWithLogits", Matrix.CategoricalCrossEntropyWithLogits, Matrix.Softmax, Matrix.CategoricalCrossEntropy},
+	}
+
+	for _, loss := range losses {
+		t.Run("computing "+loss.name, func(t *testing.T) {
+			value, gradient, err := loss.withLogits(logits, targets)
+			if err != nil {
+				t.Fatalf("Got an error while computing loss while none was expected: %v", err)
+			}
+
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				t.Fatalf("Expected a finite loss for big logits, got %v", value)
+			}
+
+			// Moderate logits, for which going through probabilities is
+			// accurate enough.
+			firstRow, _ := logits.Slice(0, 1, 0, 3)
+			firstTargets, _ := targets.Slice(0, 1, 0, 3)
+			probabilities, _ := loss.activation(firstRow)
+			expected, _, _ := loss.probability(probabilities, firstTargets)
+			actual, _, _ := loss.withLogits(firstRow, firstTargets)
+			if math.Abs(actual-expected) > 1e-9 {
+				t.Errorf("Expected loss %v, got %v", expected, actual)
+			}
+
+			expectedGradient := numericalLossGradient(loss.withLogits, logits, targets)
+			if !almostEqual(gradient, expectedGradient, 1e-5) {
+				t.Errorf("Expected gradient :%s\nGot:%s", expectedGradient, gradient)
+			}
+		})
+	}
+}
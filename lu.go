@@ -0,0 +1,217 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// singularityThreshold is the relative magnitude under which a pivot is
+// considered to be zero when factorizing a matrix.
+const singularityThreshold = 1e-12
+
+// LU holds the LU factorization with partial pivoting of a square matrix,
+// so that:
+//
+//	P * matrix = L * U
+//
+// L is unit lower triangular, U is upper triangular and P is the permutation
+// matrix described by `Pivot()`.
+type LU struct {
+	lu    Matrix
+	pivot []int
+	sign  float64
+}
+
+// LUDecompose computes the LU factorization with partial pivoting of matrix.
+//
+// Error is returned if matrix is not valid, is not square or is singular.
+func (matrix Matrix) LUDecompose() (lu LU, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	if matrix.Rows() != matrix.Cols() {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is not square`, matrix))
+		return
+	}
+
+	lu, singular := luDecompose(matrix)
+	if singular {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is singular`, matrix))
+		return
+	}
+
+	return
+}
+
+// luDecompose performs the actual factorization on a valid square matrix. It
+// also reports if a zero pivot was met, in which case the factorization can't
+// be used to solve systems, but its determinant is still meaningful.
+func luDecompose(matrix Matrix) (lu LU, singular bool) {
+	size := matrix.Rows()
+	lu.lu = make(Matrix, len(matrix))
+	copy(lu.lu, matrix)
+	lu.sign = 1.0
+	lu.pivot = make([]int, size)
+	for i := range lu.pivot {
+		lu.pivot[i] = i
+	}
+
+	threshold := singularityThreshold * maxAbs(matrix)
+
+	for k := 0; k < size; k++ {
+		pivotRow := k
+		for i := k + 1; i < size; i++ {
+			if math.Abs(lu.lu.At(i, k)) > math.Abs(lu.lu.At(pivotRow, k)) {
+				pivotRow = i
+			}
+		}
+
+		if pivotRow != k {
+			lu.lu.swapRows(pivotRow, k)
+			lu.pivot[pivotRow], lu.pivot[k] = lu.pivot[k], lu.pivot[pivotRow]
+			lu.sign = -lu.sign
+		}
+
+		pivot := lu.lu.At(k, k)
+		if math.Abs(pivot) <= threshold {
+			singular = true
+			continue
+		}
+
+		for i := k + 1; i < size; i++ {
+			factor := lu.lu.At(i, k) / pivot
+			lu.lu.SetAt(i, k, factor)
+			for j := k + 1; j < size; j++ {
+				lu.lu[lu.lu.IndexFor(i, j)] -= factor * lu.lu.At(k, j)
+			}
+		}
+	}
+
+	return
+}
+
+// L returns the unit lower triangular factor.
+func (lu LU) L() Matrix {
+	size := lu.lu.Rows()
+	resultMatrix := GenerateMatrix(size, size)
+	for i := 0; i < size; i++ {
+		for j := 0; j < i; j++ {
+			resultMatrix.SetAt(i, j, lu.lu.At(i, j))
+		}
+		resultMatrix.SetAt(i, i, 1)
+	}
+
+	return resultMatrix
+}
+
+// U returns the upper triangular factor.
+func (lu LU) U() Matrix {
+	size := lu.lu.Rows()
+	resultMatrix := GenerateMatrix(size, size)
+	for i := 0; i < size; i++ {
+		for j := i; j < size; j++ {
+			resultMatrix.SetAt(i, j, lu.lu.At(i, j))
+		}
+	}
+
+	return resultMatrix
+}
+
+// Pivot returns the row permutation applied to the original matrix: row `i`
+// of `P * matrix` is row `Pivot()[i]` of matrix.
+func (lu LU) Pivot() []int {
+	pivot := make([]int, len(lu.pivot))
+	copy(pivot, lu.pivot)
+
+	return pivot
+}
+
+// P returns the permutation matrix, so that `P * matrix = L * U`.
+func (lu LU) P() Matrix {
+	size := len(lu.pivot)
+	resultMatrix := GenerateMatrix(size, size)
+	for i, row := range lu.pivot {
+		resultMatrix.SetAt(i, row, 1)
+	}
+
+	return resultMatrix
+}
+
+// Determinant returns the determinant of the factorized matrix.
+func (lu LU) Determinant() float64 {
+	determinant := lu.sign
+	for i := 0; i < lu.lu.Rows(); i++ {
+		determinant *= lu.lu.At(i, i)
+	}
+
+	return determinant
+}
+
+// swapRows exchanges rows `first` and `second` of matrix, in place.
+func (matrix Matrix) swapRows(first, second int) {
+	cols := matrix.Cols()
+	firstStart := matrix.IndexFor(first, 0)
+	secondStart := matrix.IndexFor(second, 0)
+	for j := 0; j < cols; j++ {
+		matrix[firstStart+j], matrix[secondStart+j] = matrix[secondStart+j], matrix[firstStart+j]
+	}
+}
+
+// maxAbs returns the biggest absolute value in matrix cells.
+func maxAbs(matrix Matrix) float64 {
+	biggest := 0.0
+	for i := 2; i < len(matrix); i++ {
+		if math.Abs(matrix[i]) > biggest {
+			biggest = math.Abs(matrix[i])
+		}
+	}
+
+	return biggest
+}
+
+// Solve finds `x` so that `matrix * x = b`, matrix being the factorized matrix.
+// `b` can have several columns, in which case each column is solved
+// independently.
+//
+// Error is returned if b is not valid or if its rows count does not match
+// the factorized matrix size.
+func (lu LU) Solve(b Matrix) (resultMatrix Matrix, err error) {
+	size := lu.lu.Rows()
+	if !b.Valid() || b.Rows() != size {
+		err = generateError(fmt.Sprintf(`Can't solve system: %v is not a valid right-hand side for a %dx%d matrix`, b, size, size))
+		return
+	}
+
+	cols := b.Cols()
+	resultMatrix = GenerateMatrix(size, cols)
+	for i, row := range lu.pivot {
+		copy(resultMatrix[resultMatrix.IndexFor(i, 0):resultMatrix.IndexFor(i, cols)], b[b.IndexFor(row, 0):b.IndexFor(row, cols)])
+	}
+
+	for k := 0; k < size; k++ {
+		for i := k + 1; i < size; i++ {
+			factor := lu.lu.At(i, k)
+			for j := 0; j < cols; j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] -= factor * resultMatrix.At(k, j)
+			}
+		}
+	}
+
+	for k := size - 1; k >= 0; k-- {
+		pivot := lu.lu.At(k, k)
+		for j := 0; j < cols; j++ {
+			resultMatrix[resultMatrix.IndexFor(k, j)] /= pivot
+		}
+
+		for i := 0; i < k; i++ {
+			factor := lu.lu.At(i, k)
+			for j := 0; j < cols; j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] -= factor * resultMatrix.At(k, j)
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,161 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLUDecompose(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2, 3},
+				Row{4, 5, 6},
+				Row{7, 8, 10},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		lu, err := matrix.LUDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected, err := lu.P().DotProduct(matrix)
+		if err != nil {
+			t.Fatalf("Got an error while computing P * A while none was expected: %v", err)
+		}
+
+		actual, err := lu.L().DotProduct(lu.U())
+		if err != nil {
+			t.Fatalf("Got an error while computing L * U while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+
+		expectedPivot := []int{2, 0, 1}
+		for i, row := range lu.Pivot() {
+			if row != expectedPivot[i] {
+				t.Errorf("At position %d, expected pivot %d, got %d.", i, expectedPivot[i], row)
+			}
+		}
+
+		l := lu.L()
+		u := lu.U()
+		for i := 0; i < 3; i++ {
+			if l.At(i, i) != 1 {
+				t.Errorf("Expected 1 on L diagonal at %d, got %f", i, l.At(i, i))
+			}
+
+			for j := i + 1; j < 3; j++ {
+				if l.At(i, j) != 0 {
+					t.Errorf("Expected L to be lower triangular, got %f at (%d, %d)", l.At(i, j), i, j)
+				}
+
+				if u.At(j, i) != 0 {
+					t.Errorf("Expected U to be upper triangular, got %f at (%d, %d)", u.At(j, i), j, i)
+				}
+			}
+		}
+
+		if math.Abs(lu.Determinant()-(-3)) > 1e-12 {
+			t.Errorf("Expected determinant -3, got %f", lu.Determinant())
+		}
+	})
+
+	t.Run("with a non square matrix", func(t *testing.T) {
+		matrix := GenerateMatrix(2, 3)
+
+		_, err := matrix.LUDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with a non square matrix.")
+		}
+	})
+
+	t.Run("with a singular matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.LUDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with a singular matrix.")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.LUDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestLUSolve(t *testing.T) {
+	t.Run("with a valid right-hand side", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{0, 2, 1},
+				Row{1, 1, 0},
+				Row{3, 0, 1},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		b, err := Build(
+			Builder{
+				Row{5},
+				Row{3},
+				Row{6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building b while none was expected: %v", err)
+		}
+
+		lu, err := matrix.LUDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+		}
+
+		actual, err := lu.Solve(b)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		product, err := matrix.DotProduct(actual)
+		if err != nil {
+			t.Fatalf("Got an error while checking solution while none was expected: %v", err)
+		}
+
+		if !almostEqual(product, b, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", b, product)
+		}
+	})
+
+	t.Run("with an invalid right-hand side", func(t *testing.T) {
+		lu, err := RandomMatrix(3, 3).LUDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+		}
+
+		_, err = lu.Solve(GenerateMatrix(2, 1))
+		if err == nil {
+			t.Fatalf("Got no error with mismatching right-hand side.")
+		}
+	})
+}
@@ -6,6 +6,15 @@ import (
 
 var DEBUG bool = false
 
+// MaxIterations caps the number of iterations performed by iterative
+// algorithms, like eigenvalues computation, before giving up on convergence.
+var MaxIterations int = 1000
+
+// MaxCells caps the number of cells of matrices read from files, so that a
+// corrupted or malicious file announcing huge dimensions can't exhaust
+// memory.
+var MaxCells int = 1 << 28
+
 type Matrix []float64
 type Row []float64
 type Builder []Row
@@ -17,6 +26,22 @@ func SetDebug(debug bool) {
 	DEBUG = debug
 }
 
+/*
+ * Provide the maximum number of iterations iterative algorithms can perform
+ * before returning a convergence error.
+ */
+func SetMaxIterations(iterations int) {
+	MaxIterations = iterations
+}
+
+/*
+ * Provide the maximum number of cells matrices read from files can have
+ * before returning an error.
+ */
+func SetMaxCells(cells int) {
+	MaxCells = cells
+}
+
 func generateError(message string) (err error) {
 	if DEBUG {
 		panic(message)
@@ -35,6 +60,12 @@ func (matrix Matrix) Valid() bool {
 	return len(matrix) > 2 && len(matrix) == int(matrix[0])*int(matrix[1])+2
 }
 
+// allowedDimensions tells if a matrix of `rows` rows and `cols` cols can be
+// read from a file, according to `MaxCells`.
+func allowedDimensions(rows, cols int) bool {
+	return rows > 0 && cols > 0 && rows <= MaxCells/cols
+}
+
 /*
  * Tells if two matrices have the same dimensions and same
  * values in each cell.
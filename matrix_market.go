@@ -0,0 +1,254 @@
+package matrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadMatrixMarket reads a matrix in Matrix Market (`.mtx`) exchange format
+// into a new dense matrix.
+//
+// Both `coordinate` and `array` formats are supported, with `real`, `double`,
+// `integer` and `pattern` (coordinate only, stored values being 1) fields and
+// `general`, `symmetric` and `skew-symmetric` qualifiers. Duplicate
+// coordinate entries are summed.
+//
+// Error is returned if reader does not provide a valid Matrix Market real
+// matrix, if it has more than `MaxCells` cells, if an entry is out of the
+// matrix, or if the number of entries does not match the size line.
+func ReadMatrixMarket(reader io.Reader) (resultMatrix Matrix, err error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	if !scanner.Scan() {
+		err = generateError(`Can't read Matrix Market: missing header`)
+		return
+	}
+
+	banner := strings.Fields(strings.ToLower(scanner.Text()))
+	if len(banner) != 5 || banner[0] != "%%matrixmarket" || banner[1] != "matrix" {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: invalid header %q`, scanner.Text()))
+		return
+	}
+
+	format, field, symmetry := banner[2], banner[3], banner[4]
+	if format != "coordinate" && format != "array" {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: unsupported format %q`, format))
+		return
+	}
+
+	if field != "real" && field != "double" && field != "integer" && !(field == "pattern" && format == "coordinate") {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: unsupported field %q for %s format`, field, format))
+		return
+	}
+
+	if symmetry != "general" && symmetry != "symmetric" && symmetry != "skew-symmetric" {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: unsupported symmetry %q`, symmetry))
+		return
+	}
+
+	lines := matrixMarketLines{scanner}
+	size, ok := lines.next()
+	expectedSizeFields := 2
+	if format == "coordinate" {
+		expectedSizeFields = 3
+	}
+
+	if !ok || len(size) != expectedSizeFields {
+		err = generateError(`Can't read Matrix Market: invalid size line`)
+		return
+	}
+
+	dimensions := make([]int, len(size))
+	for i, value := range size {
+		dimensions[i], err = strconv.Atoi(value)
+		if err != nil || dimensions[i] < 0 {
+			err = generateError(fmt.Sprintf(`Can't read Matrix Market: invalid size line %v`, size))
+			return
+		}
+	}
+
+	rows, cols := dimensions[0], dimensions[1]
+	if !allowedDimensions(rows, cols) {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: %dx%d dimensions are empty or have more than %d cells`, rows, cols, MaxCells))
+		return
+	}
+
+	if symmetry != "general" && rows != cols {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: %s matrix must be square, got %dx%d`, symmetry, rows, cols))
+		return
+	}
+
+	resultMatrix = GenerateMatrix(rows, cols)
+	if format == "coordinate" {
+		err = readMatrixMarketCoordinates(lines, resultMatrix, dimensions[2], field, symmetry)
+	} else {
+		err = readMatrixMarketArray(lines, resultMatrix, symmetry)
+	}
+
+	if err == nil {
+		if _, extra := lines.next(); extra {
+			err = generateError(`Can't read Matrix Market: more entries than announced`)
+		}
+	}
+
+	if err == nil && scanner.Err() != nil {
+		err = generateError(fmt.Sprintf(`Can't read Matrix Market: %v`, scanner.Err()))
+	}
+
+	if err != nil {
+		resultMatrix = nil
+	}
+
+	return
+}
+
+// matrixMarketLines iterates over data lines, ignoring comments and empty
+// lines.
+type matrixMarketLines struct {
+	scanner *bufio.Scanner
+}
+
+// next returns the fields of next data line, if any.
+func (lines matrixMarketLines) next() (fields []string, ok bool) {
+	for lines.scanner.Scan() {
+		line := strings.TrimSpace(lines.scanner.Text())
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		fields, ok = strings.Fields(line), true
+		return
+	}
+
+	return
+}
+
+// readMatrixMarketCoordinates fills matrix with `count` coordinate entries.
+func readMatrixMarketCoordinates(lines matrixMarketLines, matrix Matrix, count int, field, symmetry string) (err error) {
+	expectedFields := 3
+	if field == "pattern" {
+		expectedFields = 2
+	}
+
+	for entry := 0; entry < count; entry++ {
+		fields, ok := lines.next()
+		if !ok {
+			err = generateError(fmt.Sprintf(`Can't read Matrix Market: %d entries announced, got %d`, count, entry))
+			return
+		}
+
+		if len(fields) != expectedFields {
+			err = generateError(fmt.Sprintf(`Can't read Matrix Market: invalid entry %v`, fields))
+			return
+		}
+
+		var row, col int
+		row, err = strconv.Atoi(fields[0])
+		if err == nil {
+			col, err = strconv.Atoi(fields[1])
+		}
+
+		if err != nil || row < 1 || row > matrix.Rows() || col < 1 || col > matrix.Cols() {
+			err = generateError(fmt.Sprintf(`Can't read Matrix Market: entry %v is out of matrix`, fields))
+			return
+		}
+
+		value := 1.0
+		if field != "pattern" {
+			value, err = strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				err = generateError(fmt.Sprintf(`Can't read Matrix Market: invalid value in entry %v`, fields))
+				return
+			}
+		}
+
+		row, col = row-1, col-1
+		matrix[matrix.IndexFor(row, col)] += value
+		if row != col {
+			switch symmetry {
+			case "symmetric":
+				matrix[matrix.IndexFor(col, row)] += value
+			case "skew-symmetric":
+				matrix[matrix.IndexFor(col, row)] -= value
+			}
+		}
+	}
+
+	return
+}
+
+// readMatrixMarketArray fills matrix with dense column-major entries. Only
+// the lower triangle is provided for symmetric matrices, without the
+// diagonal for skew-symmetric ones.
+func readMatrixMarketArray(lines matrixMarketLines, matrix Matrix, symmetry string) (err error) {
+	for col := 0; col < matrix.Cols(); col++ {
+		start := 0
+		switch symmetry {
+		case "symmetric":
+			start = col
+		case "skew-symmetric":
+			start = col + 1
+		}
+
+		for row := start; row < matrix.Rows(); row++ {
+			fields, ok := lines.next()
+			if !ok {
+				err = generateError(`Can't read Matrix Market: less entries than announced`)
+				return
+			}
+
+			if len(fields) != 1 {
+				err = generateError(fmt.Sprintf(`Can't read Matrix Market: invalid entry %v`, fields))
+				return
+			}
+
+			var value float64
+			value, err = strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				err = generateError(fmt.Sprintf(`Can't read Matrix Market: invalid value %q`, fields[0]))
+				return
+			}
+
+			matrix.SetAt(row, col, value)
+			switch symmetry {
+			case "symmetric":
+				matrix.SetAt(col, row, value)
+			case "skew-symmetric":
+				matrix.SetAt(col, row, -value)
+			}
+		}
+	}
+
+	return
+}
+
+// WriteMatrixMarket writes matrix to writer in Matrix Market `array real
+// general` format.
+//
+// Error is returned if matrix is not valid or if writing failed.
+func (matrix Matrix) WriteMatrixMarket(writer io.Writer) (err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't write matrix %v as Matrix Market: matrix is not valid`, matrix))
+		return
+	}
+
+	buffered := bufio.NewWriter(writer)
+	fmt.Fprintf(buffered, "%%%%MatrixMarket matrix array real general\n%d %d\n", matrix.Rows(), matrix.Cols())
+	for j := 0; j < matrix.Cols(); j++ {
+		for i := 0; i < matrix.Rows(); i++ {
+			buffered.WriteString(strconv.FormatFloat(matrix.At(i, j), 'g', -1, 64))
+			buffered.WriteByte('\n')
+		}
+	}
+
+	err = buffered.Flush()
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't write Matrix Market: %v`, err))
+	}
+
+	return
+}
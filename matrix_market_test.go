@@ -0,0 +1,150 @@
+package matrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadMatrixMarket(t *testing.T) {
+	valids := map[string]struct {
+		input    string
+		expected Builder
+	}{
+		"with a general coordinate matrix": {
+			"%%MatrixMarket matrix coordinate real general\n% a comment\n\n2 3 3\n1 1 1.5\n2 3 -2\n1 1 1\n",
+			Builder{Row{2.5, 0, 0}, Row{0, 0, -2}},
+		},
+		"with a symmetric coordinate matrix": {
+			"%%MatrixMarket matrix coordinate integer symmetric\n3 3 3\n1 1 4\n2 1 1\n3 2 5\n",
+			Builder{Row{4, 1, 0}, Row{1, 0, 5}, Row{0, 5, 0}},
+		},
+		"with a skew-symmetric coordinate matrix": {
+			"%%MatrixMarket matrix coordinate real skew-symmetric\n2 2 1\n2 1 3\n",
+			Builder{Row{0, -3}, Row{3, 0}},
+		},
+		"with a pattern coordinate matrix": {
+			"%%MatrixMarket matrix coordinate pattern general\n2 2 2\n1 2\n2 1\n",
+			Builder{Row{0, 1}, Row{1, 0}},
+		},
+		"with a general array matrix": {
+			"%%MatrixMarket matrix array real general\n2 3\n1\n4\n2\n5\n3\n6\n",
+			Builder{Row{1, 2, 3}, Row{4, 5, 6}},
+		},
+		"with a symmetric array matrix": {
+			"%%MatrixMarket matrix array real symmetric\n2 2\n1\n2\n3\n",
+			Builder{Row{1, 2}, Row{2, 3}},
+		},
+		"with a skew-symmetric array matrix": {
+			"%%MatrixMarket matrix array real skew-symmetric\n3 3\n1\n2\n3\n",
+			Builder{Row{0, -1, -2}, Row{1, 0, -3}, Row{2, 3, 0}},
+		},
+	}
+
+	for name, valid := range valids {
+		valid := valid
+		t.Run(name, func(t *testing.T) {
+			expected, err := Build(valid.expected)
+			if err != nil {
+				t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+			}
+
+			actual, err := ReadMatrixMarket(strings.NewReader(valid.input))
+			if err != nil {
+				t.Fatalf("Got an error while none was expected: %v", err)
+			}
+
+			if !actual.EqualTo(expected) {
+				t.Errorf("Expected :%s\nGot:%s", expected, actual)
+			}
+		})
+	}
+
+	invalids := map[string]string{
+		"with an empty input":              "",
+		"with an invalid header":           "%%MatrixMarket vector coordinate real general\n1 1 0\n",
+		"with a complex field":             "%%MatrixMarket matrix coordinate complex general\n1 1 1\n1 1 1 0\n",
+		"with an hermitian qualifier":      "%%MatrixMarket matrix coordinate real hermitian\n1 1 0\n",
+		"with a pattern array":             "%%MatrixMarket matrix array pattern general\n1 1\n",
+		"with an entry out of matrix":      "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n",
+		"with missing entries":             "%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n",
+		"with extra entries":               "%%MatrixMarket matrix array real general\n1 1\n1\n2\n",
+		"with an invalid value":            "%%MatrixMarket matrix array real general\n1 1\nfoo\n",
+		"with a non square symmetric":      "%%MatrixMarket matrix array real symmetric\n2 1\n1\n2\n",
+		"with an invalid size line":        "%%MatrixMarket matrix coordinate real general\n2 2\n",
+		"with zero dimensions":             "%%MatrixMarket matrix array real general\n0 0\n",
+		"with a malformed entry":           "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1\n",
+		"with a missing array entry":       "%%MatrixMarket matrix array real general\n2 1\n1\n",
+		"with an unsupported format":       "%%MatrixMarket matrix sparse real general\n1 1\n",
+		"with a malformed array entry":     "%%MatrixMarket matrix array real general\n1 1\n1 2\n",
+		"with an invalid coordinate":       "%%MatrixMarket matrix coordinate real general\n2 2 1\na 1 1\n",
+		"with an invalid coordinate value": "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 a\n",
+		"with huge dimensions":             "%%MatrixMarket matrix coordinate real general\n2147483647 2147483647 0\n",
+		"with overflowing dimensions":      "%%MatrixMarket matrix array real general\n4611686018427387904 4\n",
+		"with too many cells":              "%%MatrixMarket matrix coordinate real general\n100000 100000 0\n",
+	}
+
+	for name, input := range invalids {
+		input := input
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadMatrixMarket(strings.NewReader(input))
+			if err == nil {
+				t.Fatalf("Got no error with invalid input %q", input)
+			}
+		})
+	}
+}
+
+func TestWriteMatrixMarket(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2.5},
+				Row{-3, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		var buffer bytes.Buffer
+		err = matrix.WriteMatrixMarket(&buffer)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := "%%MatrixMarket matrix array real general\n2 2\n1\n-3\n2.5\n4\n"
+		if buffer.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, buffer.String())
+		}
+	})
+
+	t.Run("with a round trip", func(t *testing.T) {
+		expected := RandomMatrix(4, 7)
+
+		var buffer bytes.Buffer
+		err := expected.WriteMatrixMarket(&buffer)
+		if err != nil {
+			t.Fatalf("Got an error while writing while none was expected: %v", err)
+		}
+
+		actual, err := ReadMatrixMarket(&buffer)
+		if err != nil {
+			t.Fatalf("Got an error while reading while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+
+		var buffer bytes.Buffer
+		err := invalid.WriteMatrixMarket(&buffer)
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package matrix
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestSetDebug(t *testing.T) {
 	SetDebug(true)
@@ -89,3 +92,43 @@ func TestSameDimensions(t *testing.T) {
 		}
 	})
 }
+
+// almostEqual tells if both matrices have the same dimensions and if all
+// their cells differ by at most `tolerance`.
+func almostEqual(matrix, otherMatrix Matrix, tolerance float64) bool {
+	if !matrix.SameDimensions(otherMatrix) {
+		return false
+	}
+
+	for i := 2; i < len(matrix); i++ {
+		if math.Abs(matrix[i]-otherMatrix[i]) > tolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestSetMaxIterations(t *testing.T) {
+	previous := MaxIterations
+	SetMaxIterations(12)
+	if MaxIterations != 12 {
+		t.Errorf("Did not properly set max iterations.")
+	}
+
+	SetMaxIterations(previous)
+}
+
+func TestSetMaxCells(t *testing.T) {
+	previous := MaxCells
+	SetMaxCells(12)
+	if MaxCells != 12 {
+		t.Errorf("Did not properly set max cells.")
+	}
+
+	if !allowedDimensions(3, 4) || allowedDimensions(3, 5) || allowedDimensions(0, 4) {
+		t.Errorf("Did not properly check dimensions against max cells.")
+	}
+
+	SetMaxCells(previous)
+}
@@ -0,0 +1,141 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// FrobeniusNorm returns the square root of the sum of squares of all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) FrobeniusNorm() (norm float64, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't compute norm of matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	for i := 2; i < len(matrix); i++ {
+		norm = math.Hypot(norm, matrix[i])
+	}
+
+	return
+}
+
+// OneNorm returns the maximum absolute column sum.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) OneNorm() (norm float64, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't compute norm of matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	for j := 0; j < matrix.Cols(); j++ {
+		sum := 0.0
+		for i := 0; i < matrix.Rows(); i++ {
+			sum += math.Abs(matrix.At(i, j))
+		}
+
+		norm = math.Max(norm, sum)
+	}
+
+	return
+}
+
+// InfinityNorm returns the maximum absolute row sum.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) InfinityNorm() (norm float64, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't compute norm of matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	for i := 0; i < matrix.Rows(); i++ {
+		sum := 0.0
+		for j := 0; j < matrix.Cols(); j++ {
+			sum += math.Abs(matrix.At(i, j))
+		}
+
+		norm = math.Max(norm, sum)
+	}
+
+	return
+}
+
+// MaxAbsNorm returns the biggest absolute value among all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) MaxAbsNorm() (norm float64, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't compute norm of matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	norm = maxAbs(matrix)
+	return
+}
+
+// SpectralNorm returns the 2-norm of matrix, that is its biggest singular
+// value.
+//
+// Error is returned if matrix is not valid or if its singular value
+// decomposition did not converge.
+func (matrix Matrix) SpectralNorm() (norm float64, err error) {
+	svd, err := matrix.ThinSVDecompose()
+	if err != nil {
+		return
+	}
+
+	norm = svd.values[0]
+	return
+}
+
+// Rank returns the numerical rank of matrix, that is the number of its
+// singular values bigger than `tolerance`.
+//
+// If tolerance is zero or negative, `max(m, n) * epsilon * biggest singular
+// value` is used.
+//
+// Error is returned if matrix is not valid or if its singular value
+// decomposition did not converge.
+func (matrix Matrix) Rank(tolerance float64) (rank int, err error) {
+	svd, err := matrix.ThinSVDecompose()
+	if err != nil {
+		return
+	}
+
+	if tolerance <= 0 {
+		tolerance = defaultRankTolerance(matrix, svd.values)
+	}
+
+	for _, value := range svd.values {
+		if value > tolerance {
+			rank++
+		}
+	}
+
+	return
+}
+
+// ConditionNumber returns the 2-norm condition number of matrix, that is the
+// ratio between its biggest and smallest singular values. It is `+Inf` for
+// singular matrices.
+//
+// Error is returned if matrix is not valid or if its singular value
+// decomposition did not converge.
+func (matrix Matrix) ConditionNumber() (condition float64, err error) {
+	svd, err := matrix.ThinSVDecompose()
+	if err != nil {
+		return
+	}
+
+	smallest := svd.values[len(svd.values)-1]
+	if smallest == 0 {
+		condition = math.Inf(1)
+		return
+	}
+
+	condition = svd.values[0] / smallest
+	return
+}
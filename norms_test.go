@@ -0,0 +1,143 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNorms(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{1, -2},
+			Row{-3, 4},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	norms := map[string]struct {
+		norm     func() (float64, error)
+		expected float64
+	}{
+		"FrobeniusNorm": {matrix.FrobeniusNorm, math.Sqrt(30)},
+		"OneNorm":       {matrix.OneNorm, 6},
+		"InfinityNorm":  {matrix.InfinityNorm, 7},
+		"MaxAbsNorm":    {matrix.MaxAbsNorm, 4},
+		"SpectralNorm":  {matrix.SpectralNorm, math.Sqrt(15 + math.Sqrt(221))},
+	}
+
+	for name, norm := range norms {
+		norm := norm
+		t.Run(name, func(t *testing.T) {
+			actual, err := norm.norm()
+			if err != nil {
+				t.Fatalf("Got an error while none was expected: %v", err)
+			}
+
+			if math.Abs(actual-norm.expected) > 1e-12 {
+				t.Errorf("Expected %f, got %f", norm.expected, actual)
+			}
+		})
+	}
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+		for i, norm := range []func() (float64, error){invalid.FrobeniusNorm, invalid.OneNorm, invalid.InfinityNorm, invalid.MaxAbsNorm, invalid.SpectralNorm} {
+			_, err := norm()
+			if err == nil {
+				t.Errorf("Got no error with an invalid matrix for norm #%d.", i)
+			}
+		}
+	})
+}
+
+func TestRank(t *testing.T) {
+	t.Run("with a full rank matrix", func(t *testing.T) {
+		rank, err := IdentityMatrix(4).Rank(0)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if rank != 4 {
+			t.Errorf("Expected rank 4, got %d", rank)
+		}
+	})
+
+	t.Run("with a rank deficient matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2, 3},
+				Row{2, 4, 6},
+				Row{1, 0, 1},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		rank, err := matrix.Rank(0)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if rank != 2 {
+			t.Errorf("Expected rank 2, got %d", rank)
+		}
+	})
+
+	t.Run("with a custom tolerance", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 0},
+				Row{0, 1e-3},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		rank, err := matrix.Rank(1e-2)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if rank != 1 {
+			t.Errorf("Expected rank 1, got %d", rank)
+		}
+	})
+}
+
+func TestConditionNumber(t *testing.T) {
+	t.Run("with an invertible matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{10, 0},
+				Row{0, 0.5},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		condition, err := matrix.ConditionNumber()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if math.Abs(condition-20) > 1e-12 {
+			t.Errorf("Expected 20, got %f", condition)
+		}
+	})
+
+	t.Run("with a singular matrix", func(t *testing.T) {
+		condition, err := GenerateMatrix(2, 2).ConditionNumber()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !math.IsInf(condition, 1) {
+			t.Errorf("Expected +Inf, got %f", condition)
+		}
+	})
+}
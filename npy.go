@@ -0,0 +1,346 @@
+package matrix
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	npyMagic     = "\x93NUMPY"
+	npyAlignment = 64
+	npyChunkSize = 4096
+
+	// npyMaxHeaderLength is the longest header accepted, which is far above
+	// what NumPy produces for a 2-D array.
+	npyMaxHeaderLength = 1 << 16
+)
+
+var (
+	npyDescrPattern        = regexp.MustCompile(`['"]descr['"]\s*:\s*['"]([^'"]*)['"]`)
+	npyFortranOrderPattern = regexp.MustCompile(`['"]fortran_order['"]\s*:\s*(True|False)`)
+	npyShapePattern        = regexp.MustCompile(`['"]shape['"]\s*:\s*\(([^)]*)\)`)
+)
+
+// NPYOptions configures how matrices are written in NumPy format.
+type NPYOptions struct {
+	// Float32 writes values as float32 instead of float64, losing precision.
+	Float32 bool
+
+	// FortranOrder writes values column by column instead of row by row.
+	FortranOrder bool
+
+	// BigEndian writes values in big endian instead of little endian.
+	BigEndian bool
+}
+
+// ReadNPY reads a matrix from a NumPy `.npy` file.
+//
+// Arrays of float64 or float32, in little or big endian, and in C or Fortran
+// order are supported. 2-D arrays keep their shape, 1-D arrays of `n` values
+// become `1 x n` matrices and scalars become `1 x 1` matrices.
+//
+// Error is returned if reader does not provide a valid `.npy` file, if array
+// has another type or more than 2 dimensions, if it is empty or has more
+// than `MaxCells` cells, or if data is shorter than announced.
+func ReadNPY(reader io.Reader) (resultMatrix Matrix, err error) {
+	preamble := make([]byte, len(npyMagic)+2)
+	_, err = io.ReadFull(reader, preamble)
+	if err != nil || string(preamble[:len(npyMagic)]) != npyMagic {
+		err = generateError(`Can't read NPY: invalid magic string`)
+		return
+	}
+
+	var headerLength int
+	switch major := preamble[len(npyMagic)]; major {
+	case 1:
+		lengthBytes := make([]byte, 2)
+		_, err = io.ReadFull(reader, lengthBytes)
+		headerLength = int(binary.LittleEndian.Uint16(lengthBytes))
+	case 2, 3:
+		lengthBytes := make([]byte, 4)
+		_, err = io.ReadFull(reader, lengthBytes)
+		headerLength = int(binary.LittleEndian.Uint32(lengthBytes))
+	default:
+		err = generateError(fmt.Sprintf(`Can't read NPY: unsupported format version %d`, major))
+		return
+	}
+
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't read NPY: can't read header length: %v`, err))
+		return
+	}
+
+	if headerLength > npyMaxHeaderLength {
+		err = generateError(fmt.Sprintf(`Can't read NPY: header length %d is too big`, headerLength))
+		return
+	}
+
+	header := make([]byte, headerLength)
+	_, err = io.ReadFull(reader, header)
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't read NPY: can't read header: %v`, err))
+		return
+	}
+
+	order, size, fortranOrder, rows, cols, err := parseNPYHeader(string(header))
+	if err != nil {
+		return
+	}
+
+	// Matrix is grown chunk by chunk, so that a corrupted header can't make
+	// us allocate more memory than the file actually provides. Fortran order
+	// data is the transposed matrix read row by row.
+	values := Matrix{float64(rows), float64(cols)}
+	if fortranOrder {
+		values = Matrix{float64(cols), float64(rows)}
+	}
+
+	buffer := make([]byte, npyChunkSize*size)
+	for start := 0; start < rows*cols; start += npyChunkSize {
+		count := rows*cols - start
+		if count > npyChunkSize {
+			count = npyChunkSize
+		}
+
+		_, err = io.ReadFull(reader, buffer[:count*size])
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't read NPY: data is shorter than %dx%d shape: %v`, rows, cols, err))
+			return
+		}
+
+		for k := 0; k < count; k++ {
+			if size == 4 {
+				values = append(values, float64(math.Float32frombits(order.Uint32(buffer[k*size:]))))
+			} else {
+				values = append(values, math.Float64frombits(order.Uint64(buffer[k*size:])))
+			}
+		}
+	}
+
+	resultMatrix = values
+	if fortranOrder {
+		resultMatrix, err = values.Transpose()
+	}
+
+	return
+}
+
+// parseNPYHeader extracts data type, order and shape from a `.npy` header.
+func parseNPYHeader(header string) (order binary.ByteOrder, size int, fortranOrder bool, rows, cols int, err error) {
+	descr := npyDescrPattern.FindStringSubmatch(header)
+	fortran := npyFortranOrderPattern.FindStringSubmatch(header)
+	shape := npyShapePattern.FindStringSubmatch(header)
+	if descr == nil || fortran == nil || shape == nil {
+		err = generateError(fmt.Sprintf(`Can't read NPY: invalid header %q`, header))
+		return
+	}
+
+	switch descr[1] {
+	case "<f8":
+		order, size = binary.LittleEndian, 8
+	case ">f8":
+		order, size = binary.BigEndian, 8
+	case "<f4":
+		order, size = binary.LittleEndian, 4
+	case ">f4":
+		order, size = binary.BigEndian, 4
+	default:
+		err = generateError(fmt.Sprintf(`Can't read NPY: unsupported data type %q`, descr[1]))
+		return
+	}
+
+	fortranOrder = fortran[1] == "True"
+
+	dimensions := []int{}
+	for _, dimension := range strings.Split(shape[1], ",") {
+		dimension = strings.TrimSpace(dimension)
+		if dimension == "" {
+			continue
+		}
+
+		var value int
+		value, err = strconv.Atoi(strings.TrimSuffix(dimension, "L"))
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't read NPY: invalid shape %q`, shape[1]))
+			return
+		}
+		dimensions = append(dimensions, value)
+	}
+
+	switch len(dimensions) {
+	case 0:
+		rows, cols = 1, 1
+	case 1:
+		rows, cols = 1, dimensions[0]
+	case 2:
+		rows, cols = dimensions[0], dimensions[1]
+	default:
+		err = generateError(fmt.Sprintf(`Can't read NPY: %d dimensions arrays are not supported`, len(dimensions)))
+		return
+	}
+
+	if !allowedDimensions(rows, cols) {
+		err = generateError(fmt.Sprintf(`Can't read NPY: shape %q is empty or has more than %d cells`, shape[1], MaxCells))
+		return
+	}
+
+	return
+}
+
+// WriteNPY writes matrix to writer as a 2-D NumPy `.npy` array, following
+// options.
+//
+// Error is returned if matrix is not valid or if writing failed.
+func (matrix Matrix) WriteNPY(writer io.Writer, options NPYOptions) (err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't write matrix %v as NPY: matrix is not valid`, matrix))
+		return
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	descr := "<"
+	if options.BigEndian {
+		order = binary.BigEndian
+		descr = ">"
+	}
+
+	size := 8
+	if options.Float32 {
+		size = 4
+	}
+	descr += "f" + strconv.Itoa(size)
+
+	fortranOrder := "False"
+	if options.FortranOrder {
+		fortranOrder = "True"
+	}
+
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': %s, 'shape': (%d, %d), }", descr, fortranOrder, matrix.Rows(), matrix.Cols())
+	preambleLength := len(npyMagic) + 4
+	padding := npyAlignment - (preambleLength+len(header)+1)%npyAlignment
+	if padding == npyAlignment {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	output := bytes.NewBufferString(npyMagic)
+	output.Write([]byte{1, 0})
+	binary.Write(output, binary.LittleEndian, uint16(len(header)))
+	output.WriteString(header)
+
+	_, err = writer.Write(output.Bytes())
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't write NPY: %v`, err))
+		return
+	}
+
+	rows, cols := matrix.Rows(), matrix.Cols()
+	buffer := make([]byte, npyChunkSize*size)
+	for start := 0; start < rows*cols; start += npyChunkSize {
+		count := rows*cols - start
+		if count > npyChunkSize {
+			count = npyChunkSize
+		}
+
+		for k := 0; k < count; k++ {
+			index := start + k
+			value := matrix[index+2]
+			if options.FortranOrder {
+				value = matrix.At(index%rows, index/rows)
+			}
+
+			if size == 4 {
+				order.PutUint32(buffer[k*size:], math.Float32bits(float32(value)))
+			} else {
+				order.PutUint64(buffer[k*size:], math.Float64bits(value))
+			}
+		}
+
+		_, err = writer.Write(buffer[:count*size])
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't write NPY: %v`, err))
+			return
+		}
+	}
+
+	return
+}
+
+// ReadNPZ reads all arrays of a NumPy `.npz` archive (compressed or not),
+// mapping their names (without the `.npy` extension) to matrices.
+//
+// Error is returned if reader is not a valid zip archive or if any of its
+// arrays can't be read by `ReadNPY()`.
+func ReadNPZ(reader io.ReaderAt, size int64) (matrices map[string]Matrix, err error) {
+	archive, err := zip.NewReader(reader, size)
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't read NPZ: %v`, err))
+		return
+	}
+
+	matrices = map[string]Matrix{}
+	for _, file := range archive.File {
+		var content io.ReadCloser
+		content, err = file.Open()
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't read NPZ entry %q: %v`, file.Name, err))
+			matrices = nil
+			return
+		}
+
+		var matrix Matrix
+		matrix, err = ReadNPY(content)
+		content.Close()
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't read NPZ entry %q: %v`, file.Name, err))
+			matrices = nil
+			return
+		}
+
+		matrices[strings.TrimSuffix(file.Name, ".npy")] = matrix
+	}
+
+	return
+}
+
+// WriteNPZ writes matrices in an uncompressed NumPy `.npz` archive, each
+// matrix being stored as `<name>.npy`, following options.
+//
+// Error is returned if any matrix is not valid or if writing failed.
+func WriteNPZ(writer io.Writer, matrices map[string]Matrix, options NPYOptions) (err error) {
+	names := make([]string, 0, len(matrices))
+	for name := range matrices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	archive := zip.NewWriter(writer)
+	for _, name := range names {
+		var entry io.Writer
+		entry, err = archive.CreateHeader(&zip.FileHeader{Name: name + ".npy", Method: zip.Store})
+		if err != nil {
+			err = generateError(fmt.Sprintf(`Can't write NPZ entry %q: %v`, name, err))
+			return
+		}
+
+		err = matrices[name].WriteNPY(entry, options)
+		if err != nil {
+			return
+		}
+	}
+
+	err = archive.Close()
+	if err != nil {
+		err = generateError(fmt.Sprintf(`Can't write NPZ: %v`, err))
+	}
+
+	return
+}
@@ -0,0 +1,209 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// buildNPY produces a version 1.0 `.npy` file with provided header and data,
+// just like NumPy does.
+func buildNPY(header string, data []byte) []byte {
+	padding := 64 - (10+len(header)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	var buffer bytes.Buffer
+	buffer.WriteString("\x93NUMPY\x01\x00")
+	binary.Write(&buffer, binary.LittleEndian, uint16(len(header)))
+	buffer.WriteString(header)
+	buffer.Write(data)
+
+	return buffer.Bytes()
+}
+
+func TestReadNPY(t *testing.T) {
+	expected, err := Build(
+		Builder{
+			Row{1, 2, 3},
+			Row{4, 5, 6},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+	}
+
+	t.Run("with a C ordered float64 array", func(t *testing.T) {
+		data := make([]byte, 6*8)
+		for i := 0; i < 6; i++ {
+			binary.LittleEndian.PutUint64(data[i*8:], math.Float64bits(float64(i+1)))
+		}
+
+		actual, err := ReadNPY(bytes.NewReader(buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }", data)))
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a Fortran ordered big endian float32 array", func(t *testing.T) {
+		data := make([]byte, 6*4)
+		for i, value := range []float32{1, 4, 2, 5, 3, 6} {
+			binary.BigEndian.PutUint32(data[i*4:], math.Float32bits(value))
+		}
+
+		actual, err := ReadNPY(bytes.NewReader(buildNPY("{'descr': '>f4', 'fortran_order': True, 'shape': (2, 3), }", data)))
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !actual.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a 1-D array", func(t *testing.T) {
+		data := make([]byte, 3*8)
+		for i := 0; i < 3; i++ {
+			binary.LittleEndian.PutUint64(data[i*8:], math.Float64bits(float64(i+1)))
+		}
+
+		actual, err := ReadNPY(bytes.NewReader(buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", data)))
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if actual.Rows() != 1 || actual.Cols() != 3 || actual.At(0, 2) != 3 {
+			t.Errorf("Expected a 1x3 matrix, got %s", actual)
+		}
+	})
+
+	invalids := map[string][]byte{
+		"with an invalid magic string": []byte("NUMPY\x01\x00"),
+		"with an unsupported type":     buildNPY("{'descr': '<i8', 'fortran_order': False, 'shape': (1, 1), }", make([]byte, 8)),
+		"with 3 dimensions":            buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 1, 1), }", make([]byte, 8)),
+		"with an empty array":          buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (0, 3), }", nil),
+		"with truncated data":          buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2), }", make([]byte, 24)),
+		"with a truncated header":      buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2), }", nil)[:20],
+		"with a missing key":           buildNPY("{'descr': '<f8', 'shape': (1, 1), }", make([]byte, 8)),
+		"with a huge shape":            buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (2147483647, 2147483647), }", nil),
+		"with an overflowing shape":    buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (4611686018427387904, 4), }", nil),
+		"with a negative shape":        buildNPY("{'descr': '<f8', 'fortran_order': False, 'shape': (-2, -2), }", make([]byte, 32)),
+		"with a lying shape":           buildNPY("{'descr': '<f8', 'fortran_order': True, 'shape': (10000, 10000), }", make([]byte, 80)),
+		"with a huge header length":    []byte("\x93NUMPY\x02\x00\xff\xff\xff\xff{}"),
+	}
+
+	for name, data := range invalids {
+		data := data
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadNPY(bytes.NewReader(data))
+			if err == nil {
+				t.Fatalf("Got no error with invalid data.")
+			}
+		})
+	}
+}
+
+func TestWriteNPY(t *testing.T) {
+	t.Run("with a round trip", func(t *testing.T) {
+		expected := RandomMatrix(5, 3)
+
+		for _, useFloat32 := range []bool{false, true} {
+			for _, fortranOrder := range []bool{false, true} {
+				for _, bigEndian := range []bool{false, true} {
+					options := NPYOptions{Float32: useFloat32, FortranOrder: fortranOrder, BigEndian: bigEndian}
+					t.Run(fmt.Sprintf("%+v", options), func(t *testing.T) {
+						var buffer bytes.Buffer
+						err := expected.WriteNPY(&buffer, options)
+						if err != nil {
+							t.Fatalf("Got an error while writing while none was expected: %v", err)
+						}
+
+						headerLength := int(binary.LittleEndian.Uint16(buffer.Bytes()[8:10]))
+						if (10+headerLength)%64 != 0 {
+							t.Errorf("Expected data to be 64 bytes aligned, got header of %d bytes", headerLength)
+						}
+
+						actual, err := ReadNPY(&buffer)
+						if err != nil {
+							t.Fatalf("Got an error while reading while none was expected: %v", err)
+						}
+
+						tolerance := 0.0
+						if options.Float32 {
+							tolerance = 1e-6
+						}
+
+						if !almostEqual(expected, actual, tolerance) {
+							t.Errorf("Expected :%s\nGot:%s", expected, actual)
+						}
+					})
+				}
+			}
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+
+		var buffer bytes.Buffer
+		err := invalid.WriteNPY(&buffer, NPYOptions{})
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestNPZ(t *testing.T) {
+	t.Run("with a round trip", func(t *testing.T) {
+		expected := map[string]Matrix{
+			"weights": RandomMatrix(4, 3),
+			"bias":    RandomMatrix(1, 3),
+		}
+
+		var buffer bytes.Buffer
+		err := WriteNPZ(&buffer, expected, NPYOptions{})
+		if err != nil {
+			t.Fatalf("Got an error while writing while none was expected: %v", err)
+		}
+
+		actual, err := ReadNPZ(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		if err != nil {
+			t.Fatalf("Got an error while reading while none was expected: %v", err)
+		}
+
+		if len(actual) != len(expected) {
+			t.Fatalf("Expected %d matrices, got %d", len(expected), len(actual))
+		}
+
+		for name, matrix := range expected {
+			if !actual[name].EqualTo(matrix) {
+				t.Errorf("For %s, expected :%s\nGot:%s", name, matrix, actual[name])
+			}
+		}
+	})
+
+	t.Run("with an invalid archive", func(t *testing.T) {
+		data := []byte("not a zip archive")
+		_, err := ReadNPZ(bytes.NewReader(data), int64(len(data)))
+		if err == nil {
+			t.Fatalf("Got no error with an invalid archive.")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		var buffer bytes.Buffer
+		err := WriteNPZ(&buffer, map[string]Matrix{"invalid": Matrix([]float64{10, 10, 1})}, NPYOptions{})
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
@@ -2,7 +2,18 @@ package matrix
 
 import (
 	"fmt"
-	"math"
+	"runtime"
+	"sync"
+)
+
+const (
+	// dotProductTileSize is the number of rows and cols of each tile computed
+	// at once by `DotProduct()`.
+	dotProductTileSize = 64
+
+	// dotProductParallelThreshold is the number of multiplications above which
+	// `DotProduct()` tiles are computed in parallel.
+	dotProductParallelThreshold = 128 * 128 * 128
 )
 
 // ScalarMultiply multiplies each cell of the matrix individually
@@ -22,6 +33,10 @@ func (matrix Matrix) ScalarMultiply(scalar float64) (resultMatrix Matrix, err er
 // DotProduct performs a mathematical standard multiplication between matrix and otherMatrix,
 // and return the resulting resultMatrix.
 //
+// Computation is split in tiles, to make a better use of CPU cache. For big
+// matrices (more than `dotProductParallelThreshold` multiplications), tiles
+// are computed in parallel on `runtime.GOMAXPROCS(0)` goroutines.
+//
 // Error is returned if resultMatrix is undefined (that is, if matrix columns count is not
 // the same than otherMatrix rows count).
 func (matrix Matrix) DotProduct(otherMatrix Matrix) (resultMatrix Matrix, err error) {
@@ -31,20 +46,7 @@ func (matrix Matrix) DotProduct(otherMatrix Matrix) (resultMatrix Matrix, err er
 	}
 
 	resultMatrix = GenerateMatrix(int(matrix[0]), int(otherMatrix[1]))
-
-	for i := 0; i < int(resultMatrix[0]); i++ {
-		for j := 0; j < int(resultMatrix[1]); j++ {
-			sum := 0.0
-
-			for k := 0; k < int(matrix[1]); k++ {
-				valueInMatrix := matrix.At(i, k)
-				valueInOtherMatrix := otherMatrix.At(k, j)
-				sum += valueInMatrix * valueInOtherMatrix
-			}
-
-			resultMatrix[resultMatrix.IndexFor(i, j)] = sum
-		}
-	}
+	multiplyTiles(resultMatrix, matrix, otherMatrix)
 
 	return
 }
@@ -86,6 +88,49 @@ func (matrix Matrix) SetAt(row, col int, val float64) {
 	matrix[matrix.IndexFor(row, col)] = val
 }
 
+// SetRow replaces values of the given row (0-indexed) with the provided ones.
+//
+// Error is returned if row is out of matrix or if values count is not the
+// matrix columns count.
+func (matrix Matrix) SetRow(index int, row []float64) (err error) {
+	if index < 0 || index+1 > matrix.Rows() {
+		err = generateError(fmt.Sprintf("Row %d is out of matrix", index))
+		return
+	}
+
+	if len(row) != matrix.Cols() {
+		err = generateError(fmt.Sprintf("Can't set row: values count (%d) and matrix columns count (%d) differ", len(row), matrix.Cols()))
+		return
+	}
+
+	copy(matrix[matrix.IndexFor(index, 0):], row)
+
+	return
+}
+
+// SetCol replaces values of the given column (0-indexed) with the provided
+// ones.
+//
+// Error is returned if column is out of matrix or if values count is not the
+// matrix rows count.
+func (matrix Matrix) SetCol(index int, col []float64) (err error) {
+	if index < 0 || index+1 > matrix.Cols() {
+		err = generateError(fmt.Sprintf("Column %d is out of matrix", index))
+		return
+	}
+
+	if len(col) != matrix.Rows() {
+		err = generateError(fmt.Sprintf("Can't set column: values count (%d) and matrix rows count (%d) differ", len(col), matrix.Rows()))
+		return
+	}
+
+	for i, value := range col {
+		matrix.SetAt(i, index, value)
+	}
+
+	return
+}
+
 // Transpose switches matrix dimensions, so that, eg, a 2x3 matrix returns
 // a 3x2 one.
 //
@@ -97,12 +142,7 @@ func (matrix Matrix) Transpose() (resultMatrix Matrix, err error) {
 	}
 
 	resultMatrix = GenerateMatrix(int(matrix[1]), int(matrix[0]))
-
-	for i := 0; i < int(matrix[1]); i++ {
-		for j := 0; j < int(matrix[0]); j++ {
-			resultMatrix[resultMatrix.IndexFor(i, j)] = matrix.At(j, i)
-		}
-	}
+	err = TransposeInto(resultMatrix, matrix)
 
 	return
 }
@@ -151,11 +191,7 @@ func (matrix Matrix) Substract(otherMatrix Matrix) (resultMatrix Matrix, err err
 //
 // Error is returned if matrix is not valid.
 func (matrix Matrix) Sigmoid() (resultMatrix Matrix, err error) {
-	operation := func(value float64) float64 {
-		return 1.0 / (1.0 + math.Exp(-value))
-	}
-
-	resultMatrix, err = matrix.UnaryOperation(operation, "Sigmoid")
+	resultMatrix, err = matrix.UnaryOperation(sigmoid, "Sigmoid")
 	return
 }
 
@@ -193,10 +229,7 @@ func (matrix Matrix) BinaryOperation(otherMatrix Matrix, operation func(float64,
 	}
 
 	resultMatrix = ZeroMatrixFrom(matrix)
-
-	for i := 2; i < len(matrix); i++ {
-		resultMatrix[i] = operation(matrix[i], otherMatrix[i])
-	}
+	err = BinaryOperationInto(resultMatrix, matrix, otherMatrix, operation, operationName)
 
 	return
 }
@@ -217,10 +250,146 @@ func (matrix Matrix) UnaryOperation(operation func(float64) float64, operationNa
 	}
 
 	resultMatrix = ZeroMatrixFrom(matrix)
+	err = UnaryOperationInto(resultMatrix, matrix, operation, operationName)
+
+	return
+}
+
+// Determinant computes the determinant of a square matrix.
+//
+// Error is returned if matrix is not valid or is not square.
+func (matrix Matrix) Determinant() (determinant float64, err error) {
+	if !matrix.Valid() || matrix.Rows() != matrix.Cols() {
+		err = generateError(fmt.Sprintf(`Can't compute determinant of matrix %v: matrix is not valid or not square`, matrix))
+		return
+	}
 
-	for i := 2; i < len(matrix); i++ {
-		resultMatrix[i] = operation(matrix[i])
+	lu, singular := luDecompose(matrix)
+	if singular {
+		return
+	}
+
+	determinant = lu.Determinant()
+	return
+}
+
+// Inverse computes the inverse of a square matrix, so that
+// `matrix * inverse` is the identity matrix.
+//
+// Error is returned if matrix is not valid, is not square or is singular.
+func (matrix Matrix) Inverse() (resultMatrix Matrix, err error) {
+	lu, err := matrix.LUDecompose()
+	if err != nil {
+		return
+	}
+
+	resultMatrix, err = lu.Solve(IdentityMatrix(matrix.Rows()))
+	return
+}
+
+// PseudoInverse computes the Moore-Penrose pseudo-inverse of matrix using
+// singular value decomposition. For a `m x n` matrix, the result is a `n x m`
+// matrix.
+//
+// Singular values smaller than `max(m, n) * epsilon * biggest singular value`
+// are considered to be zero, so that rank deficient matrices are supported.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) PseudoInverse() (resultMatrix Matrix, err error) {
+	svd, err := matrix.ThinSVDecompose()
+	if err != nil {
+		return
+	}
+
+	tolerance := defaultRankTolerance(matrix, svd.values)
+
+	resultMatrix = GenerateMatrix(matrix.Cols(), matrix.Rows())
+	for k, value := range svd.values {
+		if value <= tolerance {
+			continue
+		}
+
+		for i := 0; i < resultMatrix.Rows(); i++ {
+			factor := svd.vt.At(k, i) / value
+			for j := 0; j < resultMatrix.Cols(); j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] += factor * svd.u.At(j, k)
+			}
+		}
 	}
 
 	return
 }
+
+// multiplyTiles computes `matrix * otherMatrix` in resultMatrix, which must
+// be a zero matrix of the right dimensions.
+func multiplyTiles(resultMatrix, matrix, otherMatrix Matrix) {
+	rows, cols, inner := resultMatrix.Rows(), resultMatrix.Cols(), matrix.Cols()
+	rowTiles := (rows + dotProductTileSize - 1) / dotProductTileSize
+	colTiles := (cols + dotProductTileSize - 1) / dotProductTileSize
+	tiles := rowTiles * colTiles
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > tiles {
+		workers = tiles
+	}
+
+	if rows*cols*inner < dotProductParallelThreshold || workers < 2 {
+		for tile := 0; tile < tiles; tile++ {
+			multiplyTile(resultMatrix, matrix, otherMatrix, tile/colTiles, tile%colTiles)
+		}
+		return
+	}
+
+	queue := make(chan int, tiles)
+	for tile := 0; tile < tiles; tile++ {
+		queue <- tile
+	}
+	close(queue)
+
+	var wait sync.WaitGroup
+	wait.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wait.Done()
+			for tile := range queue {
+				multiplyTile(resultMatrix, matrix, otherMatrix, tile/colTiles, tile%colTiles)
+			}
+		}()
+	}
+	wait.Wait()
+}
+
+// multiplyTile computes the cells of a single tile of `matrix * otherMatrix`
+// in resultMatrix. Tiles never overlap, so they can be computed concurrently.
+//
+// Each cell sums its products in the same order than the naive algorithm, so
+// results are identical whatever the tiling.
+func multiplyTile(resultMatrix, matrix, otherMatrix Matrix, rowTile, colTile int) {
+	rows, cols, inner := resultMatrix.Rows(), resultMatrix.Cols(), matrix.Cols()
+	rowStart, colStart := rowTile*dotProductTileSize, colTile*dotProductTileSize
+	rowEnd, colEnd := rowStart+dotProductTileSize, colStart+dotProductTileSize
+	if rowEnd > rows {
+		rowEnd = rows
+	}
+	if colEnd > cols {
+		colEnd = cols
+	}
+
+	for innerStart := 0; innerStart < inner; innerStart += dotProductTileSize {
+		innerEnd := innerStart + dotProductTileSize
+		if innerEnd > inner {
+			innerEnd = inner
+		}
+
+		for i := rowStart; i < rowEnd; i++ {
+			resultRow := resultMatrix[2+i*cols+colStart : 2+i*cols+colEnd]
+			for k := innerStart; k < innerEnd; k++ {
+				value := matrix[2+i*inner+k]
+				otherRow := otherMatrix[2+k*cols+colStart : 2+k*cols+colEnd]
+				for j, otherValue := range otherRow {
+					resultRow[j] += value * otherValue
+				}
+			}
+		}
+	}
+}
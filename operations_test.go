@@ -1,6 +1,9 @@
 package matrix
 
 import (
+	"fmt"
+	"math"
+	"runtime"
 	"testing"
 )
 
@@ -112,6 +115,66 @@ func TestDotProduct(t *testing.T) {
 	})
 }
 
+// naiveDotProduct is the straightforward triple loop implementation of
+// DotProduct, used as a reference.
+func naiveDotProduct(matrix, otherMatrix Matrix) Matrix {
+	resultMatrix := GenerateMatrix(matrix.Rows(), otherMatrix.Cols())
+	for i := 0; i < resultMatrix.Rows(); i++ {
+		for j := 0; j < resultMatrix.Cols(); j++ {
+			sum := 0.0
+			for k := 0; k < matrix.Cols(); k++ {
+				sum += matrix.At(i, k) * otherMatrix.At(k, j)
+			}
+			resultMatrix.SetAt(i, j, sum)
+		}
+	}
+
+	return resultMatrix
+}
+
+func TestDotProductTiling(t *testing.T) {
+	// makes sure parallel computation is used even on a single CPU
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	sizes := [][3]int{{1, 1, 1}, {65, 1, 130}, {63, 200, 1}, {150, 130, 170}, {300, 257, 129}}
+
+	for _, size := range sizes {
+		matrix1 := RandomMatrix(size[0], size[1])
+		matrix2 := RandomMatrix(size[1], size[2])
+
+		expected := naiveDotProduct(matrix1, matrix2)
+		actual, err := matrix1.DotProduct(matrix2)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		// tiles sum products in the same order than the naive loop, so
+		// results must be exactly the same
+		if !actual.EqualTo(expected) {
+			t.Errorf("Tiled product differs from naive one for %dx%d * %dx%d matrices", size[0], size[1], size[1], size[2])
+		}
+	}
+}
+
+func BenchmarkDotProduct(b *testing.B) {
+	for _, size := range []int{16, 64, 256, 512} {
+		matrix1 := RandomMatrix(size, size)
+		matrix2 := RandomMatrix(size, size)
+
+		b.Run(fmt.Sprintf("tiled %dx%d", size, size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				matrix1.DotProduct(matrix2)
+			}
+		})
+
+		b.Run(fmt.Sprintf("naive %dx%d", size, size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naiveDotProduct(matrix1, matrix2)
+			}
+		})
+	}
+}
+
 func TestVectorMultiply(t *testing.T) {
 	t.Run("with valid operation", func(t *testing.T) {
 		matrix1, err := Build(
@@ -172,6 +235,58 @@ func TestSetAt(t *testing.T) {
 	}
 }
 
+func TestSetRow(t *testing.T) {
+	t.Run("with valid arguments", func(t *testing.T) {
+		matrix := GenerateMatrix(2, 3)
+		err := matrix.SetRow(1, []float64{1, 2, 3})
+		if err != nil {
+			t.Fatalf("Got an unexpected error: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{0, 0, 0}, Row{1, 2, 3}})
+		if !matrix.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, matrix)
+		}
+	})
+
+	t.Run("with invalid arguments", func(t *testing.T) {
+		matrix := GenerateMatrix(2, 3)
+		if err := matrix.SetRow(2, []float64{1, 2, 3}); err == nil {
+			t.Errorf("Got no error while setting out of bound row")
+		}
+
+		if err := matrix.SetRow(0, []float64{1, 2}); err == nil {
+			t.Errorf("Got no error while setting row with wrong values count")
+		}
+	})
+}
+
+func TestSetCol(t *testing.T) {
+	t.Run("with valid arguments", func(t *testing.T) {
+		matrix := GenerateMatrix(2, 3)
+		err := matrix.SetCol(1, []float64{1, 2})
+		if err != nil {
+			t.Fatalf("Got an unexpected error: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{0, 1, 0}, Row{0, 2, 0}})
+		if !matrix.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, matrix)
+		}
+	})
+
+	t.Run("with invalid arguments", func(t *testing.T) {
+		matrix := GenerateMatrix(2, 3)
+		if err := matrix.SetCol(-1, []float64{1, 2}); err == nil {
+			t.Errorf("Got no error while setting out of bound column")
+		}
+
+		if err := matrix.SetCol(0, []float64{1, 2, 3}); err == nil {
+			t.Errorf("Got no error while setting column with wrong values count")
+		}
+	})
+}
+
 func TestTranspose(t *testing.T) {
 	t.Run("with a valid matrix", func(t *testing.T) {
 		matrix, err := Build(
@@ -627,3 +742,220 @@ func TestUnaryOperation(t *testing.T) {
 		}
 	})
 }
+
+func TestDeterminant(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{6, 1, 1},
+				Row{4, -2, 5},
+				Row{2, 8, 7},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.Determinant()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if math.Abs(actual-(-306)) > 1e-9 {
+			t.Errorf("Expected -306, got %f", actual)
+		}
+	})
+
+	t.Run("with a singular matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.Determinant()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if actual != 0 {
+			t.Errorf("Expected 0, got %f", actual)
+		}
+	})
+
+	t.Run("with a non square matrix", func(t *testing.T) {
+		_, err := GenerateMatrix(2, 3).Determinant()
+		if err == nil {
+			t.Fatalf("Got no error with a non square matrix.")
+		}
+	})
+}
+
+func TestInverse(t *testing.T) {
+	t.Run("with a valid matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{4, 7},
+				Row{2, 6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{0.6, -0.7},
+				Row{-0.2, 0.4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.Inverse()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a singular matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.Inverse()
+		if err == nil {
+			t.Fatalf("Got no error with a singular matrix.")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.Inverse()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestPseudoInverse(t *testing.T) {
+	t.Run("with a tall matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{3, 4},
+				Row{5, 6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{-4.0 / 3, -1.0 / 3, 2.0 / 3},
+				Row{13.0 / 12, 1.0 / 3, -5.0 / 12},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.PseudoInverse()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-9) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a wide matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 3, 5},
+				Row{2, 4, 6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{-4.0 / 3, 13.0 / 12},
+				Row{-1.0 / 3, 1.0 / 3},
+				Row{2.0 / 3, -5.0 / 12},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.PseudoInverse()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-9) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a rank deficient matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{0.04, 0.08},
+				Row{0.08, 0.16},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.PseudoInverse()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.PseudoInverse()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
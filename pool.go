@@ -0,0 +1,66 @@
+package matrix
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// Pool keeps matrices that are not used anymore, so that their storage can
+// be reused for new matrices instead of being allocated again.
+//
+// Matrices are sorted in buckets by capacity, each bucket holding storage
+// big enough for up to a power of two cells. Pool is safe for concurrent
+// use.
+type Pool struct {
+	buckets [bits.UintSize]sync.Pool
+}
+
+// NewPool creates an empty pool.
+func NewPool() *Pool {
+	return &Pool{}
+}
+
+// GenerateMatrix creates a zero matrix with `rows` rows and `cols` cols,
+// reusing the storage of a matrix previously put in pool when possible.
+func (pool *Pool) GenerateMatrix(rows, cols int) (matrix Matrix) {
+	size := rows*cols + 2
+	bucket := bits.Len(uint(size - 1))
+
+	if stored, ok := pool.buckets[bucket].Get().(*[]float64); ok {
+		matrix = Matrix((*stored)[:size])
+		for i := 2; i < size; i++ {
+			matrix[i] = 0
+		}
+	} else {
+		matrix = make(Matrix, size, 1<<bucket)
+	}
+
+	matrix[0] = float64(rows)
+	matrix[1] = float64(cols)
+
+	return
+}
+
+// ZeroMatrixFrom generates a Matrix having the same dimensions than origin
+// matrix, but filled with 0.0, reusing the storage of a matrix previously put
+// in pool when possible.
+func (pool *Pool) ZeroMatrixFrom(origin Matrix) Matrix {
+	return pool.GenerateMatrix(int(origin[0]), int(origin[1]))
+}
+
+// Put gives matrix back to pool, so that its storage can be reused by
+// later calls to `GenerateMatrix()`.
+//
+// Matrix must not be used anymore after that, since its values will be
+// overwritten when it's reused.
+func (pool *Pool) Put(matrix Matrix) {
+	if cap(matrix) < 2 {
+		return
+	}
+
+	// Buckets promise storage for at least 1 << bucket cells, so a matrix
+	// goes in the biggest bucket its capacity can fulfill.
+	bucket := bits.Len(uint(cap(matrix))) - 1
+	storage := []float64(matrix[:cap(matrix)])
+	pool.buckets[bucket].Put(&storage)
+}
@@ -0,0 +1,111 @@
+package matrix
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	t.Run("generating a matrix", func(t *testing.T) {
+		pool := NewPool()
+		matrix := pool.GenerateMatrix(3, 5)
+
+		if matrix.Rows() != 3 || matrix.Cols() != 5 || !matrix.Valid() {
+			t.Fatalf("Expected a valid 3x5 matrix, got %v", matrix)
+		}
+
+		if !matrix.EqualTo(GenerateMatrix(3, 5)) {
+			t.Errorf("Expected a zero matrix, got %v", matrix)
+		}
+	})
+
+	t.Run("reusing storage", func(t *testing.T) {
+		pool := NewPool()
+		matrix := pool.GenerateMatrix(4, 4)
+		matrix.SetAt(1, 1, 42)
+		pool.Put(matrix)
+
+		// sync.Pool may drop what it holds at any time, so only values are
+		// checked if storage is not reused.
+		reused := pool.GenerateMatrix(3, 4)
+		if !reused.EqualTo(GenerateMatrix(3, 4)) {
+			t.Errorf("Expected reused matrix to be zeroed, got %v", reused)
+		}
+
+		if cap(reused) < len(reused) {
+			t.Errorf("Expected enough capacity for %d cells, got %d", len(reused), cap(reused))
+		}
+	})
+
+	t.Run("putting matrices with any capacity", func(t *testing.T) {
+		pool := NewPool()
+		pool.Put(GenerateMatrix(3, 3))
+		pool.Put(nil)
+
+		for rows := 1; rows < 6; rows++ {
+			matrix := pool.GenerateMatrix(rows, 3)
+			if matrix.Rows() != rows || !matrix.Valid() {
+				t.Fatalf("Expected a valid %dx3 matrix, got %v", rows, matrix)
+			}
+			pool.Put(matrix)
+		}
+	})
+
+	t.Run("generating from another matrix", func(t *testing.T) {
+		pool := NewPool()
+		origin := RandomMatrix(2, 7)
+		matrix := pool.ZeroMatrixFrom(origin)
+
+		if !matrix.SameDimensions(origin) {
+			t.Errorf("Expected a 2x7 matrix, got %v", matrix)
+		}
+	})
+
+	t.Run("using pool concurrently", func(t *testing.T) {
+		pool := NewPool()
+		group := sync.WaitGroup{}
+
+		for worker := 0; worker < 8; worker++ {
+			group.Add(1)
+			go func(worker int) {
+				defer group.Done()
+				for i := 0; i < 100; i++ {
+					matrix := pool.GenerateMatrix(worker+1, i%10+1)
+					if !matrix.EqualTo(GenerateMatrix(worker+1, i%10+1)) {
+						t.Errorf("Expected a zero matrix, got %v", matrix)
+					}
+					matrix.SetAt(0, 0, float64(i))
+					pool.Put(matrix)
+				}
+			}(worker)
+		}
+
+		group.Wait()
+	})
+}
+
+func BenchmarkPool(b *testing.B) {
+	inputs := RandomMatrix(32, 64)
+	weights := RandomMatrix(64, 16)
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			result, _ := inputs.DotProduct(weights)
+			result.Transpose()
+		}
+	})
+
+	b.Run("pooled", func(b *testing.B) {
+		pool := NewPool()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			result := pool.GenerateMatrix(32, 16)
+			transposed := pool.GenerateMatrix(16, 32)
+			DotProductInto(result, inputs, weights)
+			TransposeInto(transposed, result)
+			pool.Put(result)
+			pool.Put(transposed)
+		}
+	})
+}
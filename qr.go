@@ -0,0 +1,209 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// QR holds the QR factorization of a matrix, so that:
+//
+//	matrix = Q * R
+//
+// Q is orthogonal and R is upper triangular.
+type QR struct {
+	q Matrix
+	r Matrix
+}
+
+// QRDecompose computes the full QR factorization of a `m x n` matrix using
+// Householder reflections. Q is `m x m` and R is `m x n`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) QRDecompose() (qr QR, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	qr = householder(matrix)
+	return
+}
+
+// ThinQRDecompose computes the thin (or "economy") QR factorization of a
+// `m x n` matrix. With `k = min(m, n)`, Q is `m x k` and R is `k x n`.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ThinQRDecompose() (qr QR, err error) {
+	qr, err = matrix.QRDecompose()
+	if err != nil {
+		return
+	}
+
+	rows, cols := matrix.Rows(), matrix.Cols()
+	size := rows
+	if cols < size {
+		size = cols
+	}
+
+	thinQ := GenerateMatrix(rows, size)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < size; j++ {
+			thinQ.SetAt(i, j, qr.q.At(i, j))
+		}
+	}
+
+	thinR := GenerateMatrix(size, cols)
+	copy(thinR[2:], qr.r[2:2+size*cols])
+
+	qr.q = thinQ
+	qr.r = thinR
+
+	return
+}
+
+// householder performs the actual factorization on a valid matrix.
+func householder(matrix Matrix) (qr QR) {
+	rows, cols := matrix.Rows(), matrix.Cols()
+	qr.q = IdentityMatrix(rows)
+	qr.r = make(Matrix, len(matrix))
+	copy(qr.r, matrix)
+
+	reflector := make([]float64, rows)
+
+	for k := 0; k < cols && k < rows-1; k++ {
+		norm := 0.0
+		for i := k; i < rows; i++ {
+			norm = math.Hypot(norm, qr.r.At(i, k))
+		}
+
+		if norm == 0 {
+			continue
+		}
+
+		alpha := -norm
+		if qr.r.At(k, k) < 0 {
+			alpha = norm
+		}
+
+		reflectorNorm := 0.0
+		for i := k; i < rows; i++ {
+			reflector[i] = qr.r.At(i, k)
+			if i == k {
+				reflector[i] -= alpha
+			}
+			reflectorNorm = math.Hypot(reflectorNorm, reflector[i])
+		}
+
+		if reflectorNorm == 0 {
+			continue
+		}
+
+		for i := k; i < rows; i++ {
+			reflector[i] /= reflectorNorm
+		}
+
+		// R = H * R, H being I - 2 * v * vT
+		for j := k; j < cols; j++ {
+			dot := 0.0
+			for i := k; i < rows; i++ {
+				dot += reflector[i] * qr.r.At(i, j)
+			}
+
+			for i := k; i < rows; i++ {
+				qr.r[qr.r.IndexFor(i, j)] -= 2 * dot * reflector[i]
+			}
+		}
+
+		// Q = Q * H
+		for i := 0; i < rows; i++ {
+			dot := 0.0
+			for j := k; j < rows; j++ {
+				dot += qr.q.At(i, j) * reflector[j]
+			}
+
+			for j := k; j < rows; j++ {
+				qr.q[qr.q.IndexFor(i, j)] -= 2 * dot * reflector[j]
+			}
+		}
+
+		qr.r.SetAt(k, k, alpha)
+		for i := k + 1; i < rows; i++ {
+			qr.r.SetAt(i, k, 0)
+		}
+	}
+
+	return
+}
+
+// Q returns the orthogonal factor.
+func (qr QR) Q() Matrix {
+	resultMatrix := make(Matrix, len(qr.q))
+	copy(resultMatrix, qr.q)
+
+	return resultMatrix
+}
+
+// R returns the upper triangular factor.
+func (qr QR) R() Matrix {
+	resultMatrix := make(Matrix, len(qr.r))
+	copy(resultMatrix, qr.r)
+
+	return resultMatrix
+}
+
+// Solve finds the least-squares solution `x` of `matrix * x = b`, matrix
+// being the factorized matrix. `b` can have several columns, in which case
+// each column is solved independently.
+//
+// Error is returned if b is not valid, if its rows count does not match the
+// factorized matrix rows count, if the factorized matrix has more columns
+// than rows or if it does not have full rank.
+func (qr QR) Solve(b Matrix) (resultMatrix Matrix, err error) {
+	rows, cols := qr.q.Rows(), qr.r.Cols()
+	if !b.Valid() || b.Rows() != rows {
+		err = generateError(fmt.Sprintf(`Can't solve system: %v is not a valid right-hand side for a %dx%d matrix`, b, rows, cols))
+		return
+	}
+
+	if rows < cols {
+		err = generateError(fmt.Sprintf(`Can't solve system: a %dx%d matrix is underdetermined`, rows, cols))
+		return
+	}
+
+	threshold := singularityThreshold * maxAbs(qr.r)
+	for k := 0; k < cols; k++ {
+		if math.Abs(qr.r.At(k, k)) <= threshold {
+			err = generateError("Can't solve system: matrix is rank deficient")
+			return
+		}
+	}
+
+	rhsCols := b.Cols()
+	resultMatrix = GenerateMatrix(cols, rhsCols)
+
+	// x = QT * b, restricted to the first `cols` rows
+	for i := 0; i < cols; i++ {
+		for j := 0; j < rhsCols; j++ {
+			sum := 0.0
+			for k := 0; k < rows; k++ {
+				sum += qr.q.At(k, i) * b.At(k, j)
+			}
+			resultMatrix.SetAt(i, j, sum)
+		}
+	}
+
+	for k := cols - 1; k >= 0; k-- {
+		for j := 0; j < rhsCols; j++ {
+			resultMatrix[resultMatrix.IndexFor(k, j)] /= qr.r.At(k, k)
+		}
+
+		for i := 0; i < k; i++ {
+			factor := qr.r.At(i, k)
+			for j := 0; j < rhsCols; j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] -= factor * resultMatrix.At(k, j)
+			}
+		}
+	}
+
+	return
+}
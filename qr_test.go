@@ -0,0 +1,160 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestQRDecompose(t *testing.T) {
+	matrices := map[string]Matrix{
+		"tall":   RandomMatrix(5, 3),
+		"square": RandomMatrix(4, 4),
+		"wide":   RandomMatrix(2, 4),
+	}
+
+	for name, matrix := range matrices {
+		for _, thin := range []bool{false, true} {
+			name := name
+			if thin {
+				name += " thin"
+			}
+
+			t.Run("with a "+name+" matrix", func(t *testing.T) {
+				var qr QR
+				var err error
+				if thin {
+					qr, err = matrix.ThinQRDecompose()
+				} else {
+					qr, err = matrix.QRDecompose()
+				}
+				if err != nil {
+					t.Fatalf("Got an error while none was expected: %v", err)
+				}
+
+				q, r := qr.Q(), qr.R()
+				size := matrix.Rows()
+				if thin && matrix.Cols() < size {
+					size = matrix.Cols()
+				}
+
+				if q.Rows() != matrix.Rows() || q.Cols() != size {
+					t.Errorf("Expected Q to be %dx%d, got %dx%d", matrix.Rows(), size, q.Rows(), q.Cols())
+				}
+
+				if r.Rows() != size || r.Cols() != matrix.Cols() {
+					t.Errorf("Expected R to be %dx%d, got %dx%d", size, matrix.Cols(), r.Rows(), r.Cols())
+				}
+
+				product, err := q.DotProduct(r)
+				if err != nil {
+					t.Fatalf("Got an error while computing Q * R while none was expected: %v", err)
+				}
+
+				if !almostEqual(matrix, product, 1e-12) {
+					t.Errorf("Expected :%s\nGot:%s", matrix, product)
+				}
+
+				transposed, _ := q.Transpose()
+				orthogonality, err := transposed.DotProduct(q)
+				if err != nil {
+					t.Fatalf("Got an error while computing QT * Q while none was expected: %v", err)
+				}
+
+				if !almostEqual(IdentityMatrix(size), orthogonality, 1e-12) {
+					t.Errorf("Expected Q to be orthogonal, got QT * Q: %s", orthogonality)
+				}
+
+				for i := 0; i < r.Rows(); i++ {
+					for j := 0; j < i && j < r.Cols(); j++ {
+						if r.At(i, j) != 0 {
+							t.Errorf("Expected R to be upper triangular, got %f at (%d, %d)", r.At(i, j), i, j)
+						}
+					}
+				}
+			})
+		}
+	}
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.QRDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestQRSolve(t *testing.T) {
+	t.Run("with a full rank matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 0},
+				Row{1, 1},
+				Row{1, 2},
+				Row{1, 3},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		b, err := Build(
+			Builder{
+				Row{1},
+				Row{3},
+				Row{4},
+				Row{4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building b while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{1.5},
+				Row{1},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		qr, err := matrix.QRDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+		}
+
+		actual, err := qr.Solve(b)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with a rank deficient matrix", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 4},
+				Row{3, 6},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		qr, err := matrix.QRDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+		}
+
+		_, err = qr.Solve(GenerateMatrix(3, 1))
+		if err == nil {
+			t.Fatalf("Got no error with a rank deficient matrix.")
+		}
+	})
+}
@@ -47,8 +47,8 @@ func (matrix Matrix) IndexFor(row, col int) int {
 
 // GetRow returns the given row (0-indexed) as a []float64.
 func (matrix Matrix) GetRow(index int) (row []float64, err error) {
-	if index+1 > matrix.Rows() {
-		err = fmt.Errorf("Row %d is out of matrix", index)
+	if index < 0 || index+1 > matrix.Rows() {
+		err = generateError(fmt.Sprintf("Row %d is out of matrix", index))
 		return
 	}
 
@@ -58,3 +58,36 @@ func (matrix Matrix) GetRow(index int) (row []float64, err error) {
 
 	return
 }
+
+// GetCol returns the given column (0-indexed) as a []float64.
+func (matrix Matrix) GetCol(index int) (col []float64, err error) {
+	if index < 0 || index+1 > matrix.Cols() {
+		err = generateError(fmt.Sprintf("Column %d is out of matrix", index))
+		return
+	}
+
+	for i := 0; i < matrix.Rows(); i++ {
+		col = append(col, matrix.At(i, index))
+	}
+
+	return
+}
+
+// Slice returns a copy of the submatrix from row `rowStart` to row `rowEnd`
+// and from column `colStart` to column `colEnd`, end indexes being excluded
+// just like when slicing an array.
+//
+// Use `View()` instead if you need to modify the submatrix.
+//
+// Error is returned if matrix is invalid or if range is empty or out of
+// matrix.
+func (matrix Matrix) Slice(rowStart, rowEnd, colStart, colEnd int) (resultMatrix Matrix, err error) {
+	view, err := matrix.View(rowStart, rowEnd, colStart, colEnd)
+	if err != nil {
+		return
+	}
+
+	resultMatrix = view.Copy()
+
+	return
+}
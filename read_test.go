@@ -107,3 +107,89 @@ func TestGetRow(t *testing.T) {
 		}
 	})
 }
+
+func TestGetCol(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{10, -5.3, 22},
+			Row{-2, -25, 12},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building the matrix while none was expected: %v", err)
+	}
+
+	t.Run("with valid argument", func(t *testing.T) {
+		col, err := matrix.GetCol(2)
+		if err != nil {
+			t.Fatalf("Got an unexpected error: %v", err)
+		}
+
+		expected := []float64{22, 12}
+		if len(col) != len(expected) {
+			t.Fatalf("Expected a column with 2 elements, got %d", len(col))
+		}
+
+		for i, val := range expected {
+			if col[i] != val {
+				t.Errorf("At position %d, expected %f, got %f", i, val, col[i])
+			}
+		}
+	})
+
+	t.Run("with invalid argument", func(t *testing.T) {
+		for _, index := range []int{-1, 3} {
+			_, err = matrix.GetCol(index)
+			if err == nil {
+				t.Errorf("Got no error while requesting out of bound column %d", index)
+			}
+		}
+	})
+}
+
+func TestSlice(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{1, 2, 3, 4},
+			Row{5, 6, 7, 8},
+			Row{9, 10, 11, 12},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building the matrix while none was expected: %v", err)
+	}
+
+	t.Run("with valid range", func(t *testing.T) {
+		slice, err := matrix.Slice(1, 3, 1, 3)
+		if err != nil {
+			t.Fatalf("Got an unexpected error: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{6, 7}, Row{10, 11}})
+		if !slice.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, slice)
+		}
+
+		slice.SetAt(0, 0, 42)
+		if matrix.At(1, 1) != 6 {
+			t.Errorf("Expected slice to be a copy, but modifying it changed matrix")
+		}
+	})
+
+	t.Run("with invalid range", func(t *testing.T) {
+		ranges := [][4]int{{-1, 2, 0, 2}, {0, 4, 0, 2}, {2, 2, 0, 2}, {0, 2, 3, 1}, {0, 2, 0, 5}}
+		for _, bounds := range ranges {
+			_, err := matrix.Slice(bounds[0], bounds[1], bounds[2], bounds[3])
+			if err == nil {
+				t.Errorf("Got no error while slicing with range %v", bounds)
+			}
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).Slice(0, 1, 0, 1)
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
@@ -0,0 +1,355 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Axis tells along which dimension a reduction is performed.
+type Axis int
+
+const (
+	// AxisAll reduces the whole matrix to a single value.
+	AxisAll Axis = -1
+
+	// Axis0 reduces along rows, producing a 1xn matrix with one value per
+	// column.
+	Axis0 Axis = 0
+
+	// Axis1 reduces along columns, producing a mx1 matrix with one value per
+	// row.
+	Axis1 Axis = 1
+)
+
+// Reduce combines cells of matrix along `axis`, by calling `operation` on
+// each cell with the result accumulated so far, starting from `initial`:
+//
+//	operation(accumulator, cell) -> accumulator
+//
+// Result is a 1x1 matrix for `AxisAll`, a 1xn matrix for `Axis0` and a mx1
+// matrix for `Axis1`.
+//
+// `operationName` is used in error message, so that it's easier to know
+// which operation error'd.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) Reduce(axis Axis, operation func(float64, float64) float64, initial float64, operationName string) (resultMatrix Matrix, err error) {
+	if err = checkReducible(matrix, axis, operationName); err != nil {
+		return
+	}
+
+	resultMatrix = reducedMatrix(matrix, axis)
+	for i := 2; i < len(resultMatrix); i++ {
+		resultMatrix[i] = initial
+	}
+
+	for i := 0; i < matrix.Rows(); i++ {
+		for j := 0; j < matrix.Cols(); j++ {
+			index := reducedIndex(resultMatrix, axis, i, j)
+			resultMatrix[index] = operation(resultMatrix[index], matrix.At(i, j))
+		}
+	}
+
+	return
+}
+
+// SumAxis computes the sum of cells along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) SumAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.Reduce(axis, func(accumulator, value float64) float64 {
+		return accumulator + value
+	}, 0, "Sum")
+	return
+}
+
+// ProductAxis computes the product of cells along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) ProductAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.Reduce(axis, func(accumulator, value float64) float64 {
+		return accumulator * value
+	}, 1, "Product")
+	return
+}
+
+// MinAxis finds the smallest cell along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) MinAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.Reduce(axis, math.Min, math.Inf(1), "Min")
+	return
+}
+
+// MaxAxis finds the biggest cell along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) MaxAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.Reduce(axis, math.Max, math.Inf(-1), "Max")
+	return
+}
+
+// MeanAxis computes the mean of cells along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) MeanAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.SumAxis(axis)
+	if err != nil {
+		return
+	}
+
+	err = resultMatrix.ScalarMultiplyInPlace(1 / float64(reducedCount(matrix, axis)))
+
+	return
+}
+
+// VarianceAxis computes the population variance of cells along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) VarianceAxis(axis Axis) (resultMatrix Matrix, err error) {
+	mean, err := matrix.MeanAxis(axis)
+	if err != nil {
+		return
+	}
+
+	deviations, err := matrix.BroadcastOperation(mean, func(value, mean float64) float64 {
+		return (value - mean) * (value - mean)
+	}, "Variance")
+	if err != nil {
+		return
+	}
+
+	resultMatrix, err = deviations.MeanAxis(axis)
+
+	return
+}
+
+// StdAxis computes the population standard deviation of cells along `axis`.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) StdAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.VarianceAxis(axis)
+	if err != nil {
+		return
+	}
+
+	err = resultMatrix.UnaryOperationInPlace(math.Sqrt, "Std")
+
+	return
+}
+
+// ArgMinAxis finds the index of the smallest cell along `axis`. The first
+// one is used if there are several.
+//
+// For `AxisAll`, the index is the position of the cell in the matrix cells
+// read row by row. Use `ArgMin()` to get a row and a column instead.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) ArgMinAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.argReduce(axis, func(value, best float64) bool {
+		return value < best
+	}, "ArgMin")
+	return
+}
+
+// ArgMaxAxis finds the index of the biggest cell along `axis`. The first one
+// is used if there are several, so that it can be used to find the class
+// predicted for each row of a classifier output with `Axis1`.
+//
+// For `AxisAll`, the index is the position of the cell in the matrix cells
+// read row by row. Use `ArgMax()` to get a row and a column instead.
+//
+// Returns error if matrix is invalid or if axis is unknown.
+func (matrix Matrix) ArgMaxAxis(axis Axis) (resultMatrix Matrix, err error) {
+	resultMatrix, err = matrix.argReduce(axis, func(value, best float64) bool {
+		return value > best
+	}, "ArgMax")
+	return
+}
+
+// Sum computes the sum of all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Sum() (sum float64, err error) {
+	sum, err = reduceAll(matrix.SumAxis(AxisAll))
+	return
+}
+
+// Product computes the product of all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Product() (product float64, err error) {
+	product, err = reduceAll(matrix.ProductAxis(AxisAll))
+	return
+}
+
+// Min finds the smallest cell.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Min() (min float64, err error) {
+	min, err = reduceAll(matrix.MinAxis(AxisAll))
+	return
+}
+
+// Max finds the biggest cell.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Max() (max float64, err error) {
+	max, err = reduceAll(matrix.MaxAxis(AxisAll))
+	return
+}
+
+// Mean computes the mean of all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Mean() (mean float64, err error) {
+	mean, err = reduceAll(matrix.MeanAxis(AxisAll))
+	return
+}
+
+// Variance computes the population variance of all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Variance() (variance float64, err error) {
+	variance, err = reduceAll(matrix.VarianceAxis(AxisAll))
+	return
+}
+
+// Std computes the population standard deviation of all cells.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) Std() (std float64, err error) {
+	std, err = reduceAll(matrix.StdAxis(AxisAll))
+	return
+}
+
+// ArgMin finds the position of the smallest cell. The first one, reading
+// row by row, is used if there are several.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ArgMin() (row, col int, err error) {
+	index, err := reduceAll(matrix.ArgMinAxis(AxisAll))
+	if err != nil {
+		return
+	}
+
+	row, col = int(index)/matrix.Cols(), int(index)%matrix.Cols()
+
+	return
+}
+
+// ArgMax finds the position of the biggest cell. The first one, reading row
+// by row, is used if there are several.
+//
+// Error is returned if matrix is not valid.
+func (matrix Matrix) ArgMax() (row, col int, err error) {
+	index, err := reduceAll(matrix.ArgMaxAxis(AxisAll))
+	if err != nil {
+		return
+	}
+
+	row, col = int(index)/matrix.Cols(), int(index)%matrix.Cols()
+
+	return
+}
+
+// argReduce finds the index of the cell for which `better` is true against
+// all other cells along `axis`.
+func (matrix Matrix) argReduce(axis Axis, better func(value, best float64) bool, operationName string) (resultMatrix Matrix, err error) {
+	if err = checkReducible(matrix, axis, operationName); err != nil {
+		return
+	}
+
+	resultMatrix = reducedMatrix(matrix, axis)
+	best := ZeroMatrixFrom(resultMatrix)
+	seen := make([]bool, len(resultMatrix))
+
+	for i := 0; i < matrix.Rows(); i++ {
+		for j := 0; j < matrix.Cols(); j++ {
+			index := reducedIndex(resultMatrix, axis, i, j)
+			value := matrix.At(i, j)
+			if seen[index] && !better(value, best[index]) {
+				continue
+			}
+
+			seen[index] = true
+			best[index] = value
+			switch axis {
+			case Axis0:
+				resultMatrix[index] = float64(i)
+			case Axis1:
+				resultMatrix[index] = float64(j)
+			default:
+				resultMatrix[index] = float64(i*matrix.Cols() + j)
+			}
+		}
+	}
+
+	return
+}
+
+// checkReducible makes sure matrix can be reduced along axis.
+func checkReducible(matrix Matrix, axis Axis, operationName string) (err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't apply reduction "%s" on matrix %v: matrix is not valid`, operationName, matrix))
+		return
+	}
+
+	if axis != AxisAll && axis != Axis0 && axis != Axis1 {
+		err = generateError(fmt.Sprintf(`Can't apply reduction "%s": unknown axis %d`, operationName, axis))
+		return
+	}
+
+	return
+}
+
+// reducedMatrix generates the zero matrix receiving the result of reducing
+// matrix along axis.
+func reducedMatrix(matrix Matrix, axis Axis) Matrix {
+	switch axis {
+	case Axis0:
+		return GenerateMatrix(1, matrix.Cols())
+	case Axis1:
+		return GenerateMatrix(matrix.Rows(), 1)
+	}
+
+	return GenerateMatrix(1, 1)
+}
+
+// reducedIndex computes the position in the reduced matrix of the cell
+// accumulating matrix cell at `row`, `col`.
+func reducedIndex(resultMatrix Matrix, axis Axis, row, col int) int {
+	switch axis {
+	case Axis0:
+		return resultMatrix.IndexFor(0, col)
+	case Axis1:
+		return resultMatrix.IndexFor(row, 0)
+	}
+
+	return resultMatrix.IndexFor(0, 0)
+}
+
+// reducedCount is the number of cells combined into each cell of the
+// reduced matrix.
+func reducedCount(matrix Matrix, axis Axis) int {
+	switch axis {
+	case Axis0:
+		return matrix.Rows()
+	case Axis1:
+		return matrix.Cols()
+	}
+
+	return matrix.Rows() * matrix.Cols()
+}
+
+// reduceAll extracts the single value of a matrix reduced with `AxisAll`.
+func reduceAll(resultMatrix Matrix, err error) (value float64, resultErr error) {
+	if err != nil {
+		resultErr = err
+		return
+	}
+
+	value = resultMatrix[2]
+
+	return
+}
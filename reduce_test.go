@@ -0,0 +1,176 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{1, 2, 3},
+			Row{4, 5, 6},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	concat := func(accumulator, value float64) float64 {
+		return accumulator*10 + value
+	}
+
+	t.Run("on the whole matrix", func(t *testing.T) {
+		result, err := matrix.Reduce(AxisAll, concat, 0, "Concat")
+		if err != nil {
+			t.Fatalf("Got an error while reducing matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{123456}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("along axis 0", func(t *testing.T) {
+		result, err := matrix.Reduce(Axis0, concat, 0, "Concat")
+		if err != nil {
+			t.Fatalf("Got an error while reducing matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{14, 25, 36}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("along axis 1", func(t *testing.T) {
+		result, err := matrix.Reduce(Axis1, concat, 0, "Concat")
+		if err != nil {
+			t.Fatalf("Got an error while reducing matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{123}, Row{456}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with an unknown axis", func(t *testing.T) {
+		_, err := matrix.Reduce(Axis(2), concat, 0, "Concat")
+		if err == nil {
+			t.Fatalf("Got no error from unknown axis")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).Reduce(AxisAll, concat, 0, "Concat")
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
+
+func TestReductions(t *testing.T) {
+	matrix, err := Build(
+		Builder{
+			Row{2, 4, 4},
+			Row{4, 5, 5},
+			Row{-1, 7, 9},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	t.Run("on the whole matrix", func(t *testing.T) {
+		scalars := []struct {
+			name      string
+			reduction func() (float64, error)
+			expected  float64
+		}{
+			{"Sum", matrix.Sum, 39},
+			{"Product", matrix.Product, -201600},
+			{"Min", matrix.Min, -1},
+			{"Max", matrix.Max, 9},
+			{"Mean", matrix.Mean, 39.0 / 9},
+			{"Variance", matrix.Variance, 64.0 / 9},
+			{"Std", matrix.Std, 8.0 / 3},
+		}
+
+		for _, scalar := range scalars {
+			value, err := scalar.reduction()
+			if err != nil {
+				t.Fatalf("Got an error while computing %s while none was expected: %v", scalar.name, err)
+			}
+
+			if math.Abs(value-scalar.expected) > 1e-12 {
+				t.Errorf("%s: expected %v, got %v", scalar.name, scalar.expected, value)
+			}
+		}
+	})
+
+	t.Run("along axes", func(t *testing.T) {
+		matrices := []struct {
+			name      string
+			reduction func(Axis) (Matrix, error)
+			axis      Axis
+			expected  Builder
+		}{
+			{"SumAxis", matrix.SumAxis, Axis0, Builder{Row{5, 16, 18}}},
+			{"SumAxis", matrix.SumAxis, Axis1, Builder{Row{10}, Row{14}, Row{15}}},
+			{"ProductAxis", matrix.ProductAxis, Axis1, Builder{Row{32}, Row{100}, Row{-63}}},
+			{"MinAxis", matrix.MinAxis, Axis0, Builder{Row{-1, 4, 4}}},
+			{"MaxAxis", matrix.MaxAxis, Axis1, Builder{Row{4}, Row{5}, Row{9}}},
+			{"MeanAxis", matrix.MeanAxis, Axis1, Builder{Row{10.0 / 3}, Row{14.0 / 3}, Row{5}}},
+			{"VarianceAxis", matrix.VarianceAxis, Axis0, Builder{Row{38.0 / 9, 14.0 / 9, 14.0 / 3}}},
+			{"StdAxis", matrix.StdAxis, Axis1, Builder{Row{math.Sqrt(8.0 / 9)}, Row{math.Sqrt(2.0 / 9)}, Row{math.Sqrt(56.0 / 3)}}},
+			{"ArgMinAxis", matrix.ArgMinAxis, Axis0, Builder{Row{2, 0, 0}}},
+			{"ArgMaxAxis", matrix.ArgMaxAxis, Axis1, Builder{Row{1}, Row{1}, Row{2}}},
+			{"ArgMaxAxis", matrix.ArgMaxAxis, AxisAll, Builder{Row{8}}},
+		}
+
+		for _, reduction := range matrices {
+			result, err := reduction.reduction(reduction.axis)
+			if err != nil {
+				t.Fatalf("Got an error while computing %s while none was expected: %v", reduction.name, err)
+			}
+
+			expected, _ := Build(reduction.expected)
+			if !almostEqual(result, expected, 1e-12) {
+				t.Errorf("%s along axis %d: expected :%s\nGot:%s", reduction.name, reduction.axis, expected, result)
+			}
+		}
+	})
+
+	t.Run("finding positions", func(t *testing.T) {
+		row, col, err := matrix.ArgMin()
+		if err != nil {
+			t.Fatalf("Got an error while computing ArgMin while none was expected: %v", err)
+		}
+
+		if row != 2 || col != 0 {
+			t.Errorf("Expected ArgMin at (2, 0), got (%d, %d)", row, col)
+		}
+
+		row, col, err = matrix.ArgMax()
+		if err != nil {
+			t.Fatalf("Got an error while computing ArgMax while none was expected: %v", err)
+		}
+
+		if row != 2 || col != 2 {
+			t.Errorf("Expected ArgMax at (2, 2), got (%d, %d)", row, col)
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		invalid := Matrix([]float64{10, 10, 1})
+		if _, err := invalid.Mean(); err == nil {
+			t.Errorf("Got no error from invalid matrix")
+		}
+
+		if _, _, err := invalid.ArgMax(); err == nil {
+			t.Errorf("Got no error from invalid matrix")
+		}
+	})
+}
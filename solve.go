@@ -0,0 +1,82 @@
+package matrix
+
+import (
+	"fmt"
+)
+
+// Solve finds `x` so that `matrix * x = b`, and returns it. `b` can have
+// several columns, in which case each column is solved independently.
+//
+// Square systems are solved using LU factorization. Overdetermined systems
+// (matrix having more rows than columns) return the least-squares solution,
+// that is the `x` minimizing the norm of `matrix * x - b`.
+//
+// Error is returned if any matrix is not valid, if b rows count differs from
+// matrix rows count, if matrix has more columns than rows or if the system
+// has no unique solution.
+func (matrix Matrix) Solve(b Matrix) (resultMatrix Matrix, err error) {
+	if !matrix.Valid() || !b.Valid() {
+		err = generateError(fmt.Sprintf(`Can't solve system: %v or %v is not valid`, matrix, b))
+		return
+	}
+
+	if matrix.Rows() != b.Rows() {
+		err = generateError(fmt.Sprintf(`Can't solve system: matrix rows count (%d) and right-hand side rows count (%d) differ`, matrix.Rows(), b.Rows()))
+		return
+	}
+
+	if matrix.Rows() < matrix.Cols() {
+		err = generateError(fmt.Sprintf(`Can't solve system: matrix %v is underdetermined`, matrix))
+		return
+	}
+
+	if matrix.Rows() > matrix.Cols() {
+		resultMatrix, err = matrix.leastSquares(b)
+		return
+	}
+
+	lu, err := matrix.LUDecompose()
+	if err != nil {
+		return
+	}
+
+	resultMatrix, err = lu.Solve(b)
+	return
+}
+
+// SolveVector finds `x` so that `matrix * x = vector`, and returns it as a new
+// []float64 vector.
+//
+// It follows the same rules than `Solve()`. Error is returned if vector has
+// not as many entries than there is matrix rows.
+func (matrix Matrix) SolveVector(vector []float64) (resultVector []float64, err error) {
+	if !matrix.Valid() || matrix.Rows() != len(vector) {
+		err = generateError(fmt.Sprintf("Vector length (%d) and matrix rows count differ, or matrix %v is not valid", len(vector), matrix))
+		return
+	}
+
+	b := GenerateMatrix(len(vector), 1)
+	copy(b[2:], vector)
+
+	resultMatrix, err := matrix.Solve(b)
+	if err != nil {
+		return
+	}
+
+	resultVector = make([]float64, resultMatrix.Rows())
+	copy(resultVector, resultMatrix[2:])
+
+	return
+}
+
+// leastSquares solves the overdetermined system `matrix * x = b` through
+// QR factorization.
+func (matrix Matrix) leastSquares(b Matrix) (resultMatrix Matrix, err error) {
+	qr, err := matrix.ThinQRDecompose()
+	if err != nil {
+		return
+	}
+
+	resultMatrix, err = qr.Solve(b)
+	return
+}
@@ -0,0 +1,175 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	t.Run("with a square system", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{2, 1, -1},
+				Row{-3, -1, 2},
+				Row{-2, 1, 2},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		b, err := Build(
+			Builder{
+				Row{8, 1},
+				Row{-11, 0},
+				Row{-3, 0},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building b while none was expected: %v", err)
+		}
+
+		actual, err := matrix.Solve(b)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		product, err := matrix.DotProduct(actual)
+		if err != nil {
+			t.Fatalf("Got an error while checking solution while none was expected: %v", err)
+		}
+
+		if !almostEqual(product, b, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", b, product)
+		}
+
+		if math.Abs(actual.At(0, 0)-2) > 1e-12 || math.Abs(actual.At(1, 0)-3) > 1e-12 || math.Abs(actual.At(2, 0)+1) > 1e-12 {
+			t.Errorf("Expected first column to be (2, 3, -1), got %s", actual)
+		}
+	})
+
+	t.Run("with an overdetermined system", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 0},
+				Row{1, 1},
+				Row{1, 2},
+				Row{1, 3},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		b, err := Build(
+			Builder{
+				Row{1},
+				Row{3},
+				Row{4},
+				Row{4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building b while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{1.5},
+				Row{1},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		actual, err := matrix.Solve(b)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with an underdetermined system", func(t *testing.T) {
+		_, err := GenerateMatrix(2, 3).Solve(GenerateMatrix(2, 1))
+		if err == nil {
+			t.Fatalf("Got no error with an underdetermined system.")
+		}
+	})
+
+	t.Run("with mismatching dimensions", func(t *testing.T) {
+		_, err := RandomMatrix(3, 3).Solve(GenerateMatrix(2, 1))
+		if err == nil {
+			t.Fatalf("Got no error with mismatching dimensions.")
+		}
+	})
+
+	t.Run("with a singular system", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{1, 2},
+				Row{2, 4},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		_, err = matrix.Solve(GenerateMatrix(2, 1))
+		if err == nil {
+			t.Fatalf("Got no error with a singular system.")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.Solve(GenerateMatrix(10, 1))
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestSolveVector(t *testing.T) {
+	t.Run("with valid operation", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{4, 3},
+				Row{6, 3},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected := []float64{1, 2}
+
+		actual, err := matrix.SolveVector([]float64{10, 12})
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if len(expected) != len(actual) {
+			t.Fatalf("Expected length (%d) different from actual length (%d).", len(expected), len(actual))
+		}
+
+		for i, val := range expected {
+			if math.Abs(actual[i]-val) > 1e-12 {
+				t.Errorf("At position %d, expected %f, got %f.", i, val, actual[i])
+			}
+		}
+	})
+
+	t.Run("with invalid operation", func(t *testing.T) {
+		matrix := GenerateMatrix(2, 2)
+
+		_, err := matrix.SolveVector([]float64{1, 2, 3})
+		if err == nil {
+			t.Fatalf("Got no error with mismatching vector length.")
+		}
+	})
+}
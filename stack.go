@@ -0,0 +1,204 @@
+package matrix
+
+import (
+	"fmt"
+)
+
+// HStack creates a new matrix by putting the provided matrices side by
+// side, from left to right.
+//
+// Error is returned if no matrix is provided, if any matrix is invalid or if
+// they don't all have the same rows count.
+func HStack(matrices ...Matrix) (resultMatrix Matrix, err error) {
+	if err = checkStackable(matrices, "HStack"); err != nil {
+		return
+	}
+
+	cols := 0
+	for _, matrix := range matrices {
+		if matrix[0] != matrices[0][0] {
+			err = generateError(fmt.Sprintf("Can't stack matrices horizontally: %v and %v do not have the same rows count", matrices[0], matrix))
+			return
+		}
+
+		cols += matrix.Cols()
+	}
+
+	resultMatrix = GenerateMatrix(matrices[0].Rows(), cols)
+	colStart := 0
+	for _, matrix := range matrices {
+		view, _ := resultMatrix.View(0, matrix.Rows(), colStart, colStart+matrix.Cols())
+		view.CopyFrom(matrix)
+		colStart += matrix.Cols()
+	}
+
+	return
+}
+
+// VStack creates a new matrix by putting the provided matrices on top of
+// each other, from top to bottom.
+//
+// Error is returned if no matrix is provided, if any matrix is invalid or if
+// they don't all have the same columns count.
+func VStack(matrices ...Matrix) (resultMatrix Matrix, err error) {
+	if err = checkStackable(matrices, "VStack"); err != nil {
+		return
+	}
+
+	rows := 0
+	for _, matrix := range matrices {
+		if matrix[1] != matrices[0][1] {
+			err = generateError(fmt.Sprintf("Can't stack matrices vertically: %v and %v do not have the same columns count", matrices[0], matrix))
+			return
+		}
+
+		rows += matrix.Rows()
+	}
+
+	// Rows are contiguous, so matrices only need to be copied one after the
+	// other.
+	resultMatrix = GenerateMatrix(rows, matrices[0].Cols())
+	start := 2
+	for _, matrix := range matrices {
+		start += copy(resultMatrix[start:], matrix[2:])
+	}
+
+	return
+}
+
+// BlockDiagonal creates a new matrix having the provided matrices on its
+// diagonal, and zeros everywhere else.
+//
+// Error is returned if no matrix is provided or if any matrix is invalid.
+func BlockDiagonal(matrices ...Matrix) (resultMatrix Matrix, err error) {
+	if err = checkStackable(matrices, "BlockDiagonal"); err != nil {
+		return
+	}
+
+	rows, cols := 0, 0
+	for _, matrix := range matrices {
+		rows += matrix.Rows()
+		cols += matrix.Cols()
+	}
+
+	resultMatrix = GenerateMatrix(rows, cols)
+	rowStart, colStart := 0, 0
+	for _, matrix := range matrices {
+		view, _ := resultMatrix.View(rowStart, rowStart+matrix.Rows(), colStart, colStart+matrix.Cols())
+		view.CopyFrom(matrix)
+		rowStart += matrix.Rows()
+		colStart += matrix.Cols()
+	}
+
+	return
+}
+
+// SplitRows splits matrix in `parts` matrices having the same number of
+// rows. This is the reverse of `VStack()`.
+//
+// Error is returned if matrix is invalid or if its rows count can't be
+// evenly divided in `parts`.
+func (matrix Matrix) SplitRows(parts int) (matrices []Matrix, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf("Can't split matrix %v: matrix is not valid", matrix))
+		return
+	}
+
+	if parts < 1 || matrix.Rows()%parts != 0 {
+		err = generateError(fmt.Sprintf("Can't split matrix: %d rows can't be split in %d parts", matrix.Rows(), parts))
+		return
+	}
+
+	rows := matrix.Rows() / parts
+	for i := 0; i < parts; i++ {
+		part, _ := matrix.Slice(i*rows, (i+1)*rows, 0, matrix.Cols())
+		matrices = append(matrices, part)
+	}
+
+	return
+}
+
+// SplitCols splits matrix in `parts` matrices having the same number of
+// columns. This is the reverse of `HStack()`.
+//
+// Error is returned if matrix is invalid or if its columns count can't be
+// evenly divided in `parts`.
+func (matrix Matrix) SplitCols(parts int) (matrices []Matrix, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf("Can't split matrix %v: matrix is not valid", matrix))
+		return
+	}
+
+	if parts < 1 || matrix.Cols()%parts != 0 {
+		err = generateError(fmt.Sprintf("Can't split matrix: %d columns can't be split in %d parts", matrix.Cols(), parts))
+		return
+	}
+
+	cols := matrix.Cols() / parts
+	for i := 0; i < parts; i++ {
+		part, _ := matrix.Slice(0, matrix.Rows(), i*cols, (i+1)*cols)
+		matrices = append(matrices, part)
+	}
+
+	return
+}
+
+// Tile creates a new matrix by repeating the whole matrix `rowsCount` times
+// vertically and `colsCount` times horizontally.
+//
+// Error is returned if matrix is invalid or if counts are not positive.
+func (matrix Matrix) Tile(rowsCount, colsCount int) (resultMatrix Matrix, err error) {
+	if !matrix.Valid() || rowsCount < 1 || colsCount < 1 {
+		err = generateError(fmt.Sprintf("Can't tile matrix %v %dx%d times: matrix is not valid or counts are not positive", matrix, rowsCount, colsCount))
+		return
+	}
+
+	rows, cols := matrix.Rows(), matrix.Cols()
+	resultMatrix = GenerateMatrix(rows*rowsCount, cols*colsCount)
+	for i := 0; i < resultMatrix.Rows(); i++ {
+		for j := 0; j < resultMatrix.Cols(); j++ {
+			resultMatrix.SetAt(i, j, matrix.At(i%rows, j%cols))
+		}
+	}
+
+	return
+}
+
+// Repeat creates a new matrix by repeating each row `rowsCount` times and
+// each column `colsCount` times, so that each cell becomes a block of
+// `rowsCount` x `colsCount` cells.
+//
+// Error is returned if matrix is invalid or if counts are not positive.
+func (matrix Matrix) Repeat(rowsCount, colsCount int) (resultMatrix Matrix, err error) {
+	if !matrix.Valid() || rowsCount < 1 || colsCount < 1 {
+		err = generateError(fmt.Sprintf("Can't repeat matrix %v %dx%d times: matrix is not valid or counts are not positive", matrix, rowsCount, colsCount))
+		return
+	}
+
+	resultMatrix = GenerateMatrix(matrix.Rows()*rowsCount, matrix.Cols()*colsCount)
+	for i := 0; i < resultMatrix.Rows(); i++ {
+		for j := 0; j < resultMatrix.Cols(); j++ {
+			resultMatrix.SetAt(i, j, matrix.At(i/rowsCount, j/colsCount))
+		}
+	}
+
+	return
+}
+
+// checkStackable makes sure at least one matrix is provided, and that all
+// provided matrices are valid.
+func checkStackable(matrices []Matrix, operationName string) (err error) {
+	if len(matrices) == 0 {
+		err = generateError(fmt.Sprintf("Can't apply %s: no matrix provided", operationName))
+		return
+	}
+
+	for _, matrix := range matrices {
+		if !matrix.Valid() {
+			err = generateError(fmt.Sprintf("Can't apply %s on matrix %v: matrix is not valid", operationName, matrix))
+			return
+		}
+	}
+
+	return
+}
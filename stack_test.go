@@ -0,0 +1,208 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestHStack(t *testing.T) {
+	matrix1, _ := Build(Builder{Row{1}, Row{2}})
+	matrix2, _ := Build(Builder{Row{3, 4}, Row{5, 6}})
+
+	t.Run("with valid matrices", func(t *testing.T) {
+		result, err := HStack(matrix1, matrix2, matrix1)
+		if err != nil {
+			t.Fatalf("Got an error while stacking matrices while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{1, 3, 4, 1}, Row{2, 5, 6, 2}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with different rows count", func(t *testing.T) {
+		_, err := HStack(matrix1, GenerateMatrix(3, 1))
+		if err == nil {
+			t.Fatalf("Got no error from matrices with different rows count")
+		}
+	})
+
+	t.Run("without matrices", func(t *testing.T) {
+		_, err := HStack()
+		if err == nil {
+			t.Fatalf("Got no error while stacking no matrix")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := HStack(matrix1, Matrix([]float64{10, 10, 1}))
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
+
+func TestVStack(t *testing.T) {
+	matrix1, _ := Build(Builder{Row{1, 2}})
+	matrix2, _ := Build(Builder{Row{3, 4}, Row{5, 6}})
+
+	t.Run("with valid matrices", func(t *testing.T) {
+		result, err := VStack(matrix1, matrix2)
+		if err != nil {
+			t.Fatalf("Got an error while stacking matrices while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{1, 2}, Row{3, 4}, Row{5, 6}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with different columns count", func(t *testing.T) {
+		_, err := VStack(matrix1, GenerateMatrix(1, 3))
+		if err == nil {
+			t.Fatalf("Got no error from matrices with different columns count")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := VStack(Matrix([]float64{10, 10, 1}))
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
+
+func TestBlockDiagonal(t *testing.T) {
+	t.Run("with valid matrices", func(t *testing.T) {
+		matrix1, _ := Build(Builder{Row{1, 2}})
+		matrix2, _ := Build(Builder{Row{3}, Row{4}})
+
+		result, err := BlockDiagonal(matrix1, matrix2)
+		if err != nil {
+			t.Fatalf("Got an error while building block diagonal while none was expected: %v", err)
+		}
+
+		expected, _ := Build(
+			Builder{
+				Row{1, 2, 0},
+				Row{0, 0, 3},
+				Row{0, 0, 4},
+			},
+		)
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("without matrices", func(t *testing.T) {
+		_, err := BlockDiagonal()
+		if err == nil {
+			t.Fatalf("Got no error while building block diagonal from no matrix")
+		}
+	})
+}
+
+func TestSplit(t *testing.T) {
+	matrix, _ := Build(
+		Builder{
+			Row{1, 2, 3, 4},
+			Row{5, 6, 7, 8},
+		},
+	)
+
+	t.Run("splitting rows", func(t *testing.T) {
+		parts, err := matrix.SplitRows(2)
+		if err != nil {
+			t.Fatalf("Got an error while splitting matrix while none was expected: %v", err)
+		}
+
+		if len(parts) != 2 {
+			t.Fatalf("Expected 2 parts, got %d", len(parts))
+		}
+
+		restored, _ := VStack(parts...)
+		if !restored.EqualTo(matrix) {
+			t.Errorf("Expected :%s\nGot:%s", matrix, restored)
+		}
+	})
+
+	t.Run("splitting columns", func(t *testing.T) {
+		parts, err := matrix.SplitCols(2)
+		if err != nil {
+			t.Fatalf("Got an error while splitting matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{3, 4}, Row{7, 8}})
+		if len(parts) != 2 || !parts[1].EqualTo(expected) {
+			t.Fatalf("Expected second part to be %s, got %v", expected, parts)
+		}
+
+		restored, _ := HStack(parts...)
+		if !restored.EqualTo(matrix) {
+			t.Errorf("Expected :%s\nGot:%s", matrix, restored)
+		}
+	})
+
+	t.Run("with uneven parts", func(t *testing.T) {
+		if _, err := matrix.SplitCols(3); err == nil {
+			t.Errorf("Got no error while splitting 4 columns in 3 parts")
+		}
+
+		if _, err := matrix.SplitRows(0); err == nil {
+			t.Errorf("Got no error while splitting rows in 0 parts")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).SplitRows(1)
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}
+
+func TestTileAndRepeat(t *testing.T) {
+	matrix, _ := Build(Builder{Row{1, 2}, Row{3, 4}})
+
+	t.Run("tiling", func(t *testing.T) {
+		result, err := matrix.Tile(2, 1)
+		if err != nil {
+			t.Fatalf("Got an error while tiling matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(
+			Builder{
+				Row{1, 2},
+				Row{3, 4},
+				Row{1, 2},
+				Row{3, 4},
+			},
+		)
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("repeating", func(t *testing.T) {
+		result, err := matrix.Repeat(1, 2)
+		if err != nil {
+			t.Fatalf("Got an error while repeating matrix while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{1, 1, 2, 2}, Row{3, 3, 4, 4}})
+		if !result.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, result)
+		}
+	})
+
+	t.Run("with invalid counts", func(t *testing.T) {
+		if _, err := matrix.Tile(0, 1); err == nil {
+			t.Errorf("Got no error while tiling 0 times")
+		}
+
+		if _, err := matrix.Repeat(1, -1); err == nil {
+			t.Errorf("Got no error while repeating -1 times")
+		}
+	})
+}
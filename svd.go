@@ -0,0 +1,316 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SVD holds the singular value decomposition of a matrix, so that:
+//
+//	matrix = U * diag(Values) * VT
+//
+// U and V have orthonormal columns, and singular values are non-negative.
+type SVD struct {
+	u      Matrix
+	values []float64
+	vt     Matrix
+}
+
+// SVDecompose computes the full singular value decomposition of a `m x n`
+// matrix using one-sided Jacobi rotations. U is `m x m`, VT is `n x n` and
+// there are `min(m, n)` singular values, in decreasing order.
+//
+// Error is returned if matrix is not valid or if computation did not
+// converge within `MaxIterations` sweeps.
+func (matrix Matrix) SVDecompose() (svd SVD, err error) {
+	svd, err = matrix.svd(true)
+	return
+}
+
+// ThinSVDecompose computes the thin singular value decomposition of a `m x n`
+// matrix. With `k = min(m, n)`, U is `m x k` and VT is `k x n`.
+//
+// Error is returned if matrix is not valid or if computation did not
+// converge within `MaxIterations` sweeps.
+func (matrix Matrix) ThinSVDecompose() (svd SVD, err error) {
+	svd, err = matrix.svd(false)
+	return
+}
+
+// svd computes the decomposition of matrix, extending U and V to square
+// matrices if `full` is true.
+func (matrix Matrix) svd(full bool) (svd SVD, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: matrix is not valid`, matrix))
+		return
+	}
+
+	transposed := matrix.Rows() < matrix.Cols()
+	tall := matrix
+	if transposed {
+		tall, err = matrix.Transpose()
+		if err != nil {
+			return
+		}
+	}
+
+	left, values, right, converged := oneSidedJacobi(tall)
+	if !converged {
+		err = generateError(fmt.Sprintf(`Can't decompose matrix %v: no convergence after %d sweeps`, matrix, MaxIterations))
+		return
+	}
+
+	size := len(values)
+	set := make([]bool, tall.Rows())
+	for j := 0; j < size; j++ {
+		set[j] = values[j] > 0
+	}
+
+	if full {
+		extended := GenerateMatrix(tall.Rows(), tall.Rows())
+		for i := 0; i < tall.Rows(); i++ {
+			for j := 0; j < size; j++ {
+				extended.SetAt(i, j, left.At(i, j))
+			}
+		}
+		left = extended
+	} else {
+		set = set[:size]
+	}
+
+	completeOrthonormalColumns(left, set)
+
+	svd.values = values
+	if transposed {
+		svd.u = right
+		svd.vt, err = left.Transpose()
+	} else {
+		svd.u = left
+		svd.vt, err = right.Transpose()
+	}
+
+	return
+}
+
+// oneSidedJacobi orthogonalizes the columns of a matrix having at least as
+// many rows than columns, returning the normalized columns, their norms (the
+// singular values) and the accumulated rotations, sorted by decreasing
+// singular value.
+func oneSidedJacobi(matrix Matrix) (left Matrix, values []float64, right Matrix, converged bool) {
+	rows, cols := matrix.Rows(), matrix.Cols()
+	work := make(Matrix, len(matrix))
+	copy(work, matrix)
+	rotations := IdentityMatrix(cols)
+
+	// columns which squared norm falls under this threshold are considered
+	// to be zero, and are not rotated anymore
+	negligible := 0.0
+	for i := 2; i < len(work); i++ {
+		negligible += work[i] * work[i]
+	}
+	negligible *= epsilon * epsilon
+
+	for sweep := 0; sweep < MaxIterations && !converged; sweep++ {
+		converged = true
+
+		for p := 0; p < cols-1; p++ {
+			for q := p + 1; q < cols; q++ {
+				alpha, beta, gamma := 0.0, 0.0, 0.0
+				for i := 0; i < rows; i++ {
+					alpha += work.At(i, p) * work.At(i, p)
+					beta += work.At(i, q) * work.At(i, q)
+					gamma += work.At(i, p) * work.At(i, q)
+				}
+
+				if alpha <= negligible || beta <= negligible || math.Abs(gamma) <= epsilon*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				zeta := (beta - alpha) / (2 * gamma)
+				tangent := 1 / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				if zeta < 0 {
+					tangent = -tangent
+				}
+				cosine := 1 / math.Sqrt(1+tangent*tangent)
+				sine := cosine * tangent
+
+				rotateColumns(work, p, q, cosine, sine)
+				rotateColumns(rotations, p, q, cosine, sine)
+			}
+		}
+	}
+
+	if !converged {
+		return
+	}
+
+	norms := make([]float64, cols)
+	order := make([]int, cols)
+	for j := 0; j < cols; j++ {
+		order[j] = j
+		for i := 0; i < rows; i++ {
+			norms[j] = math.Hypot(norms[j], work.At(i, j))
+		}
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return norms[order[a]] > norms[order[b]]
+	})
+
+	threshold := 0.0
+	if cols > 0 {
+		threshold = float64(rows) * epsilon * norms[order[0]]
+	}
+
+	left = GenerateMatrix(rows, cols)
+	right = GenerateMatrix(cols, cols)
+	values = make([]float64, cols)
+	for j, index := range order {
+		if norms[index] > threshold {
+			values[j] = norms[index]
+			for i := 0; i < rows; i++ {
+				left.SetAt(i, j, work.At(i, index)/norms[index])
+			}
+		}
+
+		for i := 0; i < cols; i++ {
+			right.SetAt(i, j, rotations.At(i, index))
+		}
+	}
+
+	return
+}
+
+// rotateColumns applies, in place, a plane rotation on columns p and q.
+func rotateColumns(matrix Matrix, p, q int, cosine, sine float64) {
+	for i := 0; i < matrix.Rows(); i++ {
+		ip, iq := matrix.At(i, p), matrix.At(i, q)
+		matrix.SetAt(i, p, cosine*ip-sine*iq)
+		matrix.SetAt(i, q, sine*ip+cosine*iq)
+	}
+}
+
+// completeOrthonormalColumns replaces, in place, the columns of matrix for
+// which `set` is false with unit vectors orthogonal to all other columns,
+// assuming the set columns are already orthonormal.
+func completeOrthonormalColumns(matrix Matrix, set []bool) {
+	rows := matrix.Rows()
+	candidate := make([]float64, rows)
+	best := make([]float64, rows)
+
+	for j := range set {
+		if set[j] {
+			continue
+		}
+
+		bestNorm := -1.0
+		for e := 0; e < rows; e++ {
+			for i := range candidate {
+				candidate[i] = 0
+			}
+			candidate[e] = 1
+
+			// orthogonalizing twice keeps good accuracy
+			for pass := 0; pass < 2; pass++ {
+				for k := range set {
+					if !set[k] {
+						continue
+					}
+
+					dot := 0.0
+					for i := 0; i < rows; i++ {
+						dot += candidate[i] * matrix.At(i, k)
+					}
+
+					for i := 0; i < rows; i++ {
+						candidate[i] -= dot * matrix.At(i, k)
+					}
+				}
+			}
+
+			norm := 0.0
+			for i := 0; i < rows; i++ {
+				norm = math.Hypot(norm, candidate[i])
+			}
+
+			if norm > bestNorm {
+				bestNorm = norm
+				copy(best, candidate)
+			}
+		}
+
+		for i := 0; i < rows; i++ {
+			matrix.SetAt(i, j, best[i]/bestNorm)
+		}
+		set[j] = true
+	}
+}
+
+// U returns the matrix which columns are the left singular vectors.
+func (svd SVD) U() Matrix {
+	resultMatrix := make(Matrix, len(svd.u))
+	copy(resultMatrix, svd.u)
+
+	return resultMatrix
+}
+
+// Values returns the singular values, in decreasing order.
+func (svd SVD) Values() []float64 {
+	values := make([]float64, len(svd.values))
+	copy(values, svd.values)
+
+	return values
+}
+
+// VT returns the matrix which rows are the right singular vectors.
+func (svd SVD) VT() Matrix {
+	resultMatrix := make(Matrix, len(svd.vt))
+	copy(resultMatrix, svd.vt)
+
+	return resultMatrix
+}
+
+// Approximation builds the best approximation of the decomposed matrix
+// having at most `rank` rank, by only keeping the `rank` biggest singular
+// values.
+//
+// Error is returned if rank is not positive.
+func (svd SVD) Approximation(rank int) (resultMatrix Matrix, err error) {
+	if rank < 1 {
+		err = generateError(fmt.Sprintf(`Can't approximate matrix with rank %d`, rank))
+		return
+	}
+
+	if rank > len(svd.values) {
+		rank = len(svd.values)
+	}
+
+	resultMatrix = GenerateMatrix(svd.u.Rows(), svd.vt.Cols())
+	for k := 0; k < rank; k++ {
+		for i := 0; i < resultMatrix.Rows(); i++ {
+			factor := svd.u.At(i, k) * svd.values[k]
+			for j := 0; j < resultMatrix.Cols(); j++ {
+				resultMatrix[resultMatrix.IndexFor(i, j)] += factor * svd.vt.At(k, j)
+			}
+		}
+	}
+
+	return
+}
+
+// defaultRankTolerance returns the threshold under which a singular value of
+// matrix is considered to be zero.
+func defaultRankTolerance(matrix Matrix, values []float64) float64 {
+	size := matrix.Rows()
+	if matrix.Cols() > size {
+		size = matrix.Cols()
+	}
+
+	if len(values) == 0 {
+		return 0
+	}
+
+	return float64(size) * epsilon * values[0]
+}
@@ -0,0 +1,185 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSVDecompose(t *testing.T) {
+	rankDeficient, err := Build(
+		Builder{
+			Row{1, 2, 3},
+			Row{2, 4, 6},
+			Row{1, 1, 1},
+			Row{0, 0, 0},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	matrices := map[string]Matrix{
+		"tall":           RandomMatrix(5, 3),
+		"square":         RandomMatrix(4, 4),
+		"wide":           RandomMatrix(2, 4),
+		"rank deficient": rankDeficient,
+	}
+
+	for name, matrix := range matrices {
+		for _, full := range []bool{false, true} {
+			name := name
+			if !full {
+				name += " thin"
+			}
+
+			t.Run("with a "+name+" matrix", func(t *testing.T) {
+				var svd SVD
+				var err error
+				if full {
+					svd, err = matrix.SVDecompose()
+				} else {
+					svd, err = matrix.ThinSVDecompose()
+				}
+				if err != nil {
+					t.Fatalf("Got an error while none was expected: %v", err)
+				}
+
+				u, values, vt := svd.U(), svd.Values(), svd.VT()
+				rows, cols := matrix.Rows(), matrix.Cols()
+				size := rows
+				if cols < size {
+					size = cols
+				}
+
+				if len(values) != size {
+					t.Fatalf("Expected %d singular values, got %d", size, len(values))
+				}
+
+				for i := 1; i < len(values); i++ {
+					if values[i] > values[i-1] || values[i] < 0 {
+						t.Errorf("Expected non-negative singular values in decreasing order, got %v", values)
+					}
+				}
+
+				expectedUCols, expectedVTRows := size, size
+				if full {
+					expectedUCols, expectedVTRows = rows, cols
+				}
+
+				if u.Rows() != rows || u.Cols() != expectedUCols {
+					t.Errorf("Expected U to be %dx%d, got %dx%d", rows, expectedUCols, u.Rows(), u.Cols())
+				}
+
+				if vt.Rows() != expectedVTRows || vt.Cols() != cols {
+					t.Errorf("Expected VT to be %dx%d, got %dx%d", expectedVTRows, cols, vt.Rows(), vt.Cols())
+				}
+
+				uT, _ := u.Transpose()
+				orthogonality, _ := uT.DotProduct(u)
+				if !almostEqual(IdentityMatrix(u.Cols()), orthogonality, 1e-12) {
+					t.Errorf("Expected U to have orthonormal columns, got UT * U: %s", orthogonality)
+				}
+
+				v, _ := vt.Transpose()
+				orthogonality, _ = vt.DotProduct(v)
+				if !almostEqual(IdentityMatrix(vt.Rows()), orthogonality, 1e-12) {
+					t.Errorf("Expected V to have orthonormal columns, got VT * V: %s", orthogonality)
+				}
+
+				sigma := GenerateMatrix(u.Cols(), vt.Rows())
+				for i, value := range values {
+					sigma.SetAt(i, i, value)
+				}
+
+				product, _ := u.DotProduct(sigma)
+				product, _ = product.DotProduct(vt)
+				if !almostEqual(matrix, product, 1e-12) {
+					t.Errorf("Expected :%s\nGot:%s", matrix, product)
+				}
+			})
+		}
+	}
+
+	t.Run("with known singular values", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{3, 0},
+				Row{4, 5},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		svd, err := matrix.SVDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		expected := []float64{3 * math.Sqrt(5), math.Sqrt(5)}
+		for i, value := range svd.Values() {
+			if math.Abs(value-expected[i]) > 1e-12 {
+				t.Errorf("At position %d, expected %f, got %f.", i, expected[i], value)
+			}
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		matrix := Matrix([]float64{10, 10, 1})
+
+		_, err := matrix.SVDecompose()
+		if err == nil {
+			t.Fatalf("Got no error with an invalid matrix.")
+		}
+	})
+}
+
+func TestSVDApproximation(t *testing.T) {
+	t.Run("with a valid rank", func(t *testing.T) {
+		matrix, err := Build(
+			Builder{
+				Row{3, 0},
+				Row{0, 1},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+		}
+
+		expected, err := Build(
+			Builder{
+				Row{3, 0},
+				Row{0, 0},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Got an error while building expected matrix while none was expected: %v", err)
+		}
+
+		svd, err := matrix.SVDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+		}
+
+		actual, err := svd.Approximation(1)
+		if err != nil {
+			t.Fatalf("Got an error while none was expected: %v", err)
+		}
+
+		if !almostEqual(expected, actual, 1e-12) {
+			t.Errorf("Expected :%s\nGot:%s", expected, actual)
+		}
+	})
+
+	t.Run("with an invalid rank", func(t *testing.T) {
+		svd, err := RandomMatrix(3, 3).SVDecompose()
+		if err != nil {
+			t.Fatalf("Got an error while decomposing while none was expected: %v", err)
+		}
+
+		_, err = svd.Approximation(0)
+		if err == nil {
+			t.Fatalf("Got no error with an invalid rank.")
+		}
+	})
+}
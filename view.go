@@ -0,0 +1,184 @@
+package matrix
+
+import (
+	"fmt"
+)
+
+// View is a submatrix sharing its storage with the matrix it comes from:
+// setting a value in view sets it in parent matrix, and the other way
+// around.
+//
+// Views are meant to operate on blocks of a matrix without copying them. Use
+// `Copy()` to get a regular matrix out of a view.
+type View struct {
+	parent   Matrix
+	rowStart int
+	colStart int
+	rows     int
+	cols     int
+}
+
+// View returns a view on the submatrix from row `rowStart` to row `rowEnd`
+// and from column `colStart` to column `colEnd`, end indexes being excluded
+// just like when slicing an array.
+//
+// Error is returned if matrix is invalid or if range is empty or out of
+// matrix.
+func (matrix Matrix) View(rowStart, rowEnd, colStart, colEnd int) (view View, err error) {
+	if !matrix.Valid() {
+		err = generateError(fmt.Sprintf("Can't create view of matrix %v: matrix is not valid", matrix))
+		return
+	}
+
+	if !validRange(matrix.Rows(), matrix.Cols(), rowStart, rowEnd, colStart, colEnd) {
+		err = generateError(fmt.Sprintf("Can't create view [%d:%d, %d:%d]: range is empty or out of %dx%d matrix", rowStart, rowEnd, colStart, colEnd, matrix.Rows(), matrix.Cols()))
+		return
+	}
+
+	view = View{
+		parent:   matrix,
+		rowStart: rowStart,
+		colStart: colStart,
+		rows:     rowEnd - rowStart,
+		cols:     colEnd - colStart,
+	}
+
+	return
+}
+
+// Rows returns the number of rows in the view.
+func (view View) Rows() int {
+	return view.rows
+}
+
+// Cols returns the number of columns in the view.
+func (view View) Cols() int {
+	return view.cols
+}
+
+// At returns the value at position `row`, `col` in the view.
+//
+// Just like an array, you're responsible to make sure
+// you don't ask for an out of range value.
+func (view View) At(row, col int) float64 {
+	return view.parent[view.indexFor(row, col)]
+}
+
+// SetAt sets value at given row and col in the view, and thus in parent
+// matrix.
+//
+// You're responsible for making sure the position at row and col actually
+// exists in the view.
+func (view View) SetAt(row, col int, val float64) {
+	view.parent[view.indexFor(row, col)] = val
+}
+
+// Row returns the given row (0-indexed) of the view. The row shares its
+// storage with parent matrix, so modifying it modifies the matrix.
+func (view View) Row(index int) (row []float64, err error) {
+	if index < 0 || index+1 > view.rows {
+		err = generateError(fmt.Sprintf("Row %d is out of view", index))
+		return
+	}
+
+	start := view.indexFor(index, 0)
+	row = view.parent[start : start+view.cols : start+view.cols]
+
+	return
+}
+
+// View returns a view on a submatrix of view, with indexes relative to view.
+//
+// Error is returned if range is empty or out of view.
+func (view View) View(rowStart, rowEnd, colStart, colEnd int) (subView View, err error) {
+	if !validRange(view.rows, view.cols, rowStart, rowEnd, colStart, colEnd) {
+		err = generateError(fmt.Sprintf("Can't create view [%d:%d, %d:%d]: range is empty or out of %dx%d view", rowStart, rowEnd, colStart, colEnd, view.rows, view.cols))
+		return
+	}
+
+	subView = View{
+		parent:   view.parent,
+		rowStart: view.rowStart + rowStart,
+		colStart: view.colStart + colStart,
+		rows:     rowEnd - rowStart,
+		cols:     colEnd - colStart,
+	}
+
+	return
+}
+
+// Copy returns a new matrix holding the values of view.
+func (view View) Copy() (resultMatrix Matrix) {
+	resultMatrix = GenerateMatrix(view.rows, view.cols)
+	for i := 0; i < view.rows; i++ {
+		start := view.indexFor(i, 0)
+		copy(resultMatrix[resultMatrix.IndexFor(i, 0):], view.parent[start:start+view.cols])
+	}
+
+	return
+}
+
+// CopyFrom replaces values of view, and thus of parent matrix, with the
+// values of source.
+//
+// Error is returned if source is not valid or doesn't have the dimensions
+// of view.
+func (view View) CopyFrom(source Matrix) (err error) {
+	if !source.Valid() || source.Rows() != view.rows || source.Cols() != view.cols {
+		err = generateError(fmt.Sprintf("Can't copy matrix %v in %dx%d view: matrix is not valid or not of the same dimensions", source, view.rows, view.cols))
+		return
+	}
+
+	for i := 0; i < view.rows; i++ {
+		start := source.IndexFor(i, 0)
+		copy(view.parent[view.indexFor(i, 0):], source[start:start+view.cols])
+	}
+
+	return
+}
+
+// UnaryOperationInPlace applies `operation` on each cell of view, modifying
+// parent matrix.
+//
+// Since a view is always valid, no error can happen.
+func (view View) UnaryOperationInPlace(operation func(float64) float64) {
+	for i := 0; i < view.rows; i++ {
+		start := view.indexFor(i, 0)
+		for j := start; j < start+view.cols; j++ {
+			view.parent[j] = operation(view.parent[j])
+		}
+	}
+}
+
+// BinaryOperationInPlace applies `operation` cell by cell on view and
+// otherMatrix, and stores the result in view, modifying parent matrix.
+//
+// Returns error if otherMatrix is not valid or is not of the dimensions of
+// view.
+func (view View) BinaryOperationInPlace(otherMatrix Matrix, operation func(float64, float64) float64, operationName string) (err error) {
+	if !otherMatrix.Valid() || otherMatrix.Rows() != view.rows || otherMatrix.Cols() != view.cols {
+		err = generateError(fmt.Sprintf(`Can't apply operation "%s" on %dx%d view and matrix %v: matrix is not valid or not of the same dimensions`, operationName, view.rows, view.cols, otherMatrix))
+		return
+	}
+
+	for i := 0; i < view.rows; i++ {
+		start := view.indexFor(i, 0)
+		for j := 0; j < view.cols; j++ {
+			view.parent[start+j] = operation(view.parent[start+j], otherMatrix.At(i, j))
+		}
+	}
+
+	return
+}
+
+// indexFor computes the position of given cell of view in the underlying
+// array representation of parent matrix.
+func (view View) indexFor(row, col int) int {
+	return view.parent.IndexFor(view.rowStart+row, view.colStart+col)
+}
+
+// validRange tells if the given range is non empty and fits in a matrix of
+// `rows` rows and `cols` cols.
+func validRange(rows, cols, rowStart, rowEnd, colStart, colEnd int) bool {
+	return rowStart >= 0 && rowStart < rowEnd && rowEnd <= rows && colStart >= 0 && colStart < colEnd && colEnd <= cols
+}
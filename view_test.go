@@ -0,0 +1,180 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func buildViewTestMatrix(t *testing.T) Matrix {
+	matrix, err := Build(
+		Builder{
+			Row{1, 2, 3, 4},
+			Row{5, 6, 7, 8},
+			Row{9, 10, 11, 12},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Got an error while building matrix while none was expected: %v", err)
+	}
+
+	return matrix
+}
+
+func TestView(t *testing.T) {
+	t.Run("reading values", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		view, err := matrix.View(1, 3, 1, 4)
+		if err != nil {
+			t.Fatalf("Got an error while creating view while none was expected: %v", err)
+		}
+
+		if view.Rows() != 2 || view.Cols() != 3 {
+			t.Fatalf("Expected a 2x3 view, got %dx%d", view.Rows(), view.Cols())
+		}
+
+		expected, _ := Build(Builder{Row{6, 7, 8}, Row{10, 11, 12}})
+		copied := view.Copy()
+		if !copied.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, copied)
+		}
+
+		if view.At(1, 2) != 12 {
+			t.Errorf("Expected 12, got %f", view.At(1, 2))
+		}
+	})
+
+	t.Run("sharing storage with parent", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		view, _ := matrix.View(1, 3, 0, 2)
+
+		view.SetAt(0, 1, 42)
+		if matrix.At(1, 1) != 42 {
+			t.Errorf("Expected view modification to change parent, got %f", matrix.At(1, 1))
+		}
+
+		matrix.SetAt(2, 0, -1)
+		if view.At(1, 0) != -1 {
+			t.Errorf("Expected parent modification to change view, got %f", view.At(1, 0))
+		}
+	})
+
+	t.Run("getting a row", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		view, _ := matrix.View(0, 2, 1, 3)
+
+		row, err := view.Row(1)
+		if err != nil {
+			t.Fatalf("Got an error while getting row while none was expected: %v", err)
+		}
+
+		if len(row) != 2 || row[0] != 6 || row[1] != 7 {
+			t.Fatalf("Expected [6 7], got %v", row)
+		}
+
+		row[0] = 0
+		if matrix.At(1, 1) != 0 {
+			t.Errorf("Expected row modification to change parent, got %f", matrix.At(1, 1))
+		}
+
+		row = append(row, 100)
+		if matrix.At(1, 3) != 8 {
+			t.Errorf("Expected appending to row not to change parent, got %f", matrix.At(1, 3))
+		}
+
+		if _, err := view.Row(2); err == nil {
+			t.Errorf("Got no error while requesting out of bound row")
+		}
+	})
+
+	t.Run("creating a sub view", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		view, _ := matrix.View(1, 3, 1, 4)
+
+		subView, err := view.View(1, 2, 1, 3)
+		if err != nil {
+			t.Fatalf("Got an error while creating sub view while none was expected: %v", err)
+		}
+
+		expected, _ := Build(Builder{Row{11, 12}})
+		copied := subView.Copy()
+		if !copied.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, copied)
+		}
+
+		if _, err := view.View(0, 3, 0, 1); err == nil {
+			t.Errorf("Got no error while creating sub view out of view")
+		}
+	})
+
+	t.Run("copying values into view", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		view, _ := matrix.View(0, 2, 2, 4)
+
+		source, _ := Build(Builder{Row{-1, -2}, Row{-3, -4}})
+		err := view.CopyFrom(source)
+		if err != nil {
+			t.Fatalf("Got an error while copying into view while none was expected: %v", err)
+		}
+
+		expected, _ := Build(
+			Builder{
+				Row{1, 2, -1, -2},
+				Row{5, 6, -3, -4},
+				Row{9, 10, 11, 12},
+			},
+		)
+		if !matrix.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, matrix)
+		}
+
+		if err := view.CopyFrom(GenerateMatrix(2, 3)); err == nil {
+			t.Errorf("Got no error while copying matrix of wrong dimensions")
+		}
+	})
+
+	t.Run("applying operations", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		view, _ := matrix.View(1, 2, 0, 4)
+
+		view.UnaryOperationInPlace(func(value float64) float64 { return value * 2 })
+
+		other, _ := Build(Builder{Row{1, 1, 1, 1}})
+		err := view.BinaryOperationInPlace(other, func(value1, value2 float64) float64 { return value1 + value2 }, "Add")
+		if err != nil {
+			t.Fatalf("Got an error while applying operation while none was expected: %v", err)
+		}
+
+		expected, _ := Build(
+			Builder{
+				Row{1, 2, 3, 4},
+				Row{11, 13, 15, 17},
+				Row{9, 10, 11, 12},
+			},
+		)
+		if !matrix.EqualTo(expected) {
+			t.Errorf("Expected :%s\nGot:%s", expected, matrix)
+		}
+
+		err = view.BinaryOperationInPlace(GenerateMatrix(2, 2), func(value1, value2 float64) float64 { return value1 }, "First")
+		if err == nil {
+			t.Errorf("Got no error from matrix of wrong dimensions")
+		}
+	})
+
+	t.Run("with invalid range", func(t *testing.T) {
+		matrix := buildViewTestMatrix(t)
+		if _, err := matrix.View(0, 4, 0, 1); err == nil {
+			t.Errorf("Got no error while creating view out of matrix")
+		}
+
+		if _, err := matrix.View(1, 1, 0, 1); err == nil {
+			t.Errorf("Got no error while creating empty view")
+		}
+	})
+
+	t.Run("with an invalid matrix", func(t *testing.T) {
+		_, err := Matrix([]float64{10, 10, 1}).View(0, 1, 0, 1)
+		if err == nil {
+			t.Fatalf("Got no error from invalid matrix")
+		}
+	})
+}